@@ -1146,6 +1146,13 @@ func AutocompleteImageSaveFormat(cmd *cobra.Command, args []string, toComplete s
 	return formats, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteStateStores - Autocomplete the state database backends recognized by `podman system migrate --new-db`.
+// -> "boltdb", "sqlite"
+func AutocompleteStateStores(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	stores := []string{"boltdb", "sqlite"}
+	return stores, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteWaitCondition - Autocomplete wait condition options.
 // -> "unknown", "configured", "created", "running", "stopped", "paused", "exited", "removing"
 func AutocompleteWaitCondition(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -459,6 +459,12 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		)
 		_ = cmd.RegisterFlagCompletionFunc(restartFlagName, AutocompleteRestartOption)
 
+		createFlags.BoolVar(
+			&cf.CheckpointOnStop,
+			"checkpoint-on-stop", false,
+			"Checkpoint the container on stop, and restore it on start",
+		)
+
 		createFlags.BoolVar(
 			&cf.Rm,
 			"rm", false,
@@ -616,6 +622,12 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		)
 		_ = cmd.RegisterFlagCompletionFunc(seccompPolicyFlagName, completion.AutocompleteDefault)
 
+		createFlags.BoolVar(
+			&cf.SeccompTrace,
+			"seccomp-trace", false,
+			"Record the syscalls made by the container, for use with `podman generate seccomp`",
+		)
+
 		cgroupConfFlagName := "cgroup-conf"
 		createFlags.StringSliceVar(
 			&cf.CgroupConf,
@@ -885,6 +897,14 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		)
 		_ = cmd.RegisterFlagCompletionFunc(cpusetMemsFlagName, completion.AutocompleteNone)
 
+		cpuAffinityFlagName := "cpu-affinity"
+		createFlags.StringVar(
+			&cf.CPUAffinity,
+			cpuAffinityFlagName, "",
+			"CPU affinity mode. The only accepted value is \"auto\", which pins the container to a single host core, spreading containers evenly across cores",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(cpuAffinityFlagName, completion.AutocompleteNone)
+
 		memoryFlagName := "memory"
 		createFlags.StringVarP(
 			&cf.Memory,
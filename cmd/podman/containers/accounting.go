@@ -0,0 +1,144 @@
+package containers
+
+import (
+	"os"
+	"time"
+
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+var (
+	accountingDescription = `Display the peak memory usage, total CPU time, block IO and network counters recorded for one or more containers at their last exit.
+
+  Containers that have never exited show no accounting data.`
+	accountingCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "accounting [options] [CONTAINER...]",
+		Short:             "Display resource usage recorded at container exit",
+		Long:              accountingDescription,
+		RunE:              accounting,
+		ValidArgsFunction: common.AutocompleteContainers,
+		Example: `podman container accounting ctrID
+  podman container accounting --latest
+  podman container accounting --format json ctrID`,
+	}
+)
+
+var (
+	accountingOptions entities.ContainerAccountingOptions
+	accountingFormat  string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: accountingCommand,
+		Parent:  containerCmd,
+	})
+	flags := accountingCommand.Flags()
+
+	formatFlagName := "format"
+	flags.StringVar(&accountingFormat, formatFlagName, "", "Pretty-print container accounting to JSON or using a Go template")
+	_ = accountingCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(entities.ContainerAccountingReport{}))
+
+	validate.AddLatestFlag(accountingCommand, &accountingOptions.Latest)
+}
+
+func accounting(cmd *cobra.Command, args []string) error {
+	reports, err := registry.ContainerEngine().ContainerAccounting(registry.Context(), args, accountingOptions)
+	if err != nil {
+		return err
+	}
+
+	if report.IsJSON(accountingFormat) {
+		return outputAccountingJSON(reports)
+	}
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	if cmd.Flags().Changed("format") {
+		rpt, err = rpt.Parse(report.OriginUser, accountingFormat)
+	} else {
+		format := "{{range .}}{{.Id}}\t{{.Name}}\t{{.PeakMemUsage}}\t{{.CPUTime}}\t{{.BlockIO}}\t{{.NetIO}}\n{{end -}}"
+		rpt, err = rpt.Parse(report.OriginPodman, format)
+	}
+	if err != nil {
+		return err
+	}
+
+	headers := report.Headers(containerAccounting{}, map[string]string{
+		"Id":           "CONTAINER ID",
+		"PeakMemUsage": "PEAK MEM",
+		"CPUTime":      "CPU TIME",
+		"BlockIO":      "BLOCK IO",
+		"NetIO":        "NET IO",
+	})
+	rows := make([]containerAccounting, 0, len(reports))
+	for _, r := range reports {
+		rows = append(rows, containerAccounting{r})
+	}
+
+	if rpt.RenderHeaders {
+		if err := rpt.Execute(headers); err != nil {
+			return err
+		}
+	}
+	return rpt.Execute(rows)
+}
+
+type containerAccounting struct {
+	*entities.ContainerAccountingReport
+}
+
+func (a containerAccounting) accountingOrEmpty() define.ContainerResourceAccounting {
+	if a.ResourceAccounting == nil {
+		return define.ContainerResourceAccounting{}
+	}
+	return *a.ResourceAccounting
+}
+
+func (a containerAccounting) PeakMemUsage() string {
+	if a.ResourceAccounting == nil {
+		return "--"
+	}
+	return units.HumanSize(float64(a.accountingOrEmpty().PeakMemUsage))
+}
+
+func (a containerAccounting) CPUTime() string {
+	if a.ResourceAccounting == nil {
+		return "--"
+	}
+	return time.Duration(a.accountingOrEmpty().CPUTimeNano).String()
+}
+
+func (a containerAccounting) BlockIO() string {
+	if a.ResourceAccounting == nil {
+		return "-- / --"
+	}
+	acct := a.accountingOrEmpty()
+	return combineHumanValues(acct.BlockInput, acct.BlockOutput)
+}
+
+func (a containerAccounting) NetIO() string {
+	if a.ResourceAccounting == nil {
+		return "-- / --"
+	}
+	acct := a.accountingOrEmpty()
+	return combineHumanValues(acct.NetInput, acct.NetOutput)
+}
+
+func outputAccountingJSON(reports []*entities.ContainerAccountingReport) error {
+	b, err := json.MarshalIndent(reports, "", " ")
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(append(b, '\n'))
+	return err
+}
@@ -78,6 +78,10 @@ func init() {
 		"Display checkpoint statistics",
 	)
 
+	createImageFlagName := "create-image"
+	flags.StringVar(&checkpointOptions.CreateImage, createImageFlagName, "", "Create a checkpoint image with the given name")
+	_ = checkpointCommand.RegisterFlagCompletionFunc(createImageFlagName, completion.AutocompleteDefault)
+
 	validate.AddLatestFlag(checkpointCommand, &checkpointOptions.Latest)
 }
 
@@ -113,6 +113,14 @@ func logsFlags(cmd *cobra.Command) {
 
 	flags.BoolVarP(&logsOptions.Timestamps, "timestamps", "t", false, "Output the timestamps in the log")
 	flags.BoolVarP(&logsOptions.Names, "names", "n", false, "Output the container name in the log")
+	flags.BoolVar(&logsOptions.Colors, "color", false, "Output the containers with a different color")
+
+	grepFlagName := "grep"
+	flags.StringVar(&logsOptions.Grep, grepFlagName, "", "Only output lines that match this RE2 regular expression, filtered server-side")
+	_ = cmd.RegisterFlagCompletionFunc(grepFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&logsOptions.GrepInvert, "grep-invert", false, "Invert the --grep match, only outputting non-matching lines")
+
 	flags.SetInterspersed(false)
 	_ = flags.MarkHidden("details")
 }
@@ -0,0 +1,143 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/bindings"
+	bindingsContainers "github.com/containers/podman/v4/pkg/bindings/containers"
+	"github.com/containers/podman/v4/pkg/criu"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/containers/podman/v4/pkg/rootless"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	migrateDescription = `
+   podman container migrate
+
+   Live-migrates a container to another Podman host by checkpointing it
+   locally, transferring the checkpoint over the remote API, and restoring
+   it on the destination. This chains checkpoint, transfer and restore into
+   a single command instead of requiring a manual export/scp/import cycle.
+`
+	migrateCommand = &cobra.Command{
+		Use:               "migrate [options] CONTAINER DESTINATION",
+		Short:             "Migrate a container to another Podman host",
+		Long:              migrateDescription,
+		Args:              cobra.ExactArgs(2),
+		RunE:              migrate,
+		ValidArgsFunction: common.AutocompleteContainersRunning,
+		Example: `podman container migrate mywebserver production
+  podman container migrate --volumes mywebserver production`,
+	}
+)
+
+var migrateOptions = struct {
+	Volumes        bool
+	TCPEstablished bool
+	LeaveRunning   bool
+	PreDump        bool
+}{}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: migrateCommand,
+		Parent:  containerCmd,
+	})
+	flags := migrateCommand.Flags()
+	flags.BoolVar(&migrateOptions.Volumes, "volumes", false, "Also transfer named volumes associated with the container")
+	flags.BoolVar(&migrateOptions.TCPEstablished, "tcp-established", false, "Migrate a container with established TCP connections")
+	flags.BoolVar(&migrateOptions.LeaveRunning, "leave-running", false, "Leave the source container running after a successful migration")
+	flags.BoolVar(&migrateOptions.PreDump, "pre-dump", false, "Take a pre-copy memory dump before the final freeze, to shrink the final downtime window")
+}
+
+func migrate(cmd *cobra.Command, args []string) error {
+	if rootless.IsRootless() {
+		return errors.New("migrating a container requires root")
+	}
+
+	ctrNameOrID := args[0]
+	destination := args[1]
+
+	cfg, err := config.ReadCustomConfig()
+	if err != nil {
+		return errors.Wrap(err, "reading podman configuration")
+	}
+	dest, found := cfg.Engine.ServiceDestinations[destination]
+	if !found {
+		return errors.Errorf("%q destination not found, see 'podman system connection add'", destination)
+	}
+
+	if migrateOptions.PreDump && !criu.MemTrack() {
+		return errors.New("system (architecture/kernel/CRIU) does not support memory tracking required for --pre-dump")
+	}
+
+	tarFile, err := ioutil.TempFile("", "podman-migrate-*.tar")
+	if err != nil {
+		return err
+	}
+	tarFile.Close()
+	defer os.Remove(tarFile.Name())
+
+	if migrateOptions.PreDump {
+		fmt.Printf("Pre-dumping memory of %s...\n", ctrNameOrID)
+		preDumpReports, err := registry.ContainerEngine().ContainerCheckpoint(registry.GetContext(), []string{ctrNameOrID}, entities.CheckpointOptions{
+			PreCheckPoint: true,
+			LeaveRunning:  true,
+		})
+		if err != nil {
+			return errors.Wrap(err, "pre-dumping container memory")
+		}
+		if len(preDumpReports) != 1 {
+			return errors.Errorf("expected 1 checkpoint report but got %d", len(preDumpReports))
+		}
+		if preDumpReports[0].Err != nil {
+			return errors.Wrap(preDumpReports[0].Err, "pre-dumping container memory")
+		}
+	}
+
+	fmt.Printf("Checkpointing %s...\n", ctrNameOrID)
+	checkpointOptions := entities.CheckpointOptions{
+		Export:         tarFile.Name(),
+		TCPEstablished: migrateOptions.TCPEstablished,
+		IgnoreVolumes:  !migrateOptions.Volumes,
+		LeaveRunning:   migrateOptions.LeaveRunning,
+		WithPrevious:   migrateOptions.PreDump,
+	}
+	reports, err := registry.ContainerEngine().ContainerCheckpoint(registry.GetContext(), []string{ctrNameOrID}, checkpointOptions)
+	if err != nil {
+		return errors.Wrap(err, "checkpointing container")
+	}
+	if len(reports) != 1 {
+		return errors.Errorf("expected 1 checkpoint report but got %d", len(reports))
+	}
+	if reports[0].Err != nil {
+		return errors.Wrap(reports[0].Err, "checkpointing container")
+	}
+
+	fmt.Printf("Transferring checkpoint to %s...\n", destination)
+	destCtx, err := bindings.NewConnectionWithIdentity(context.Background(), dest.URI, dest.Identity)
+	if err != nil {
+		return errors.Wrapf(err, "connecting to destination %q", destination)
+	}
+
+	restoreOptions := new(bindingsContainers.RestoreOptions)
+	restoreOptions.WithImportArchive(tarFile.Name())
+	restoreOptions.WithTCPEstablished(migrateOptions.TCPEstablished)
+
+	fmt.Printf("Restoring %s on %s...\n", ctrNameOrID, destination)
+	restoreReport, err := bindingsContainers.Restore(destCtx, "", restoreOptions)
+	if err != nil {
+		return errors.Wrapf(err, "restoring container on %q", destination)
+	}
+
+	fmt.Println(restoreReport.Id)
+	return nil
+}
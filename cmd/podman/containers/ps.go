@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	tm "github.com/buger/goterm"
@@ -14,7 +15,9 @@ import (
 	"github.com/containers/podman/v4/cmd/podman/registry"
 	"github.com/containers/podman/v4/cmd/podman/utils"
 	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/containers/podman/v4/pkg/bindings"
 	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/containers/podman/v4/pkg/domain/infra/tunnel"
 	"github.com/docker/go-units"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -49,8 +52,9 @@ var (
 	listOpts = entities.ContainerListOptions{
 		Filters: make(map[string][]string),
 	}
-	filters []string
-	noTrunc bool
+	filters     []string
+	noTrunc     bool
+	connections []string
 )
 
 func init() {
@@ -86,10 +90,15 @@ func listFlagSet(cmd *cobra.Command) {
 	flags.IntVarP(&listOpts.Last, lastFlagName, "n", -1, "Print the n last created containers (all states)")
 	_ = cmd.RegisterFlagCompletionFunc(lastFlagName, completion.AutocompleteNone)
 
+	offsetFlagName := "offset"
+	flags.IntVar(&listOpts.Offset, offsetFlagName, 0, "Skip the n last created containers (all states) before applying --last")
+	_ = cmd.RegisterFlagCompletionFunc(offsetFlagName, completion.AutocompleteNone)
+
 	flags.BoolVar(&listOpts.Namespace, "ns", false, "Display namespace information")
 	flags.BoolVar(&noTrunc, "no-trunc", false, "Display the extended information")
 	flags.BoolVarP(&listOpts.Pod, "pod", "p", false, "Print the ID and name of the pod the containers are associated with")
 	flags.BoolVarP(&listOpts.Quiet, "quiet", "q", false, "Print the numeric IDs of the containers only")
+	flags.BoolVar(&listOpts.Quick, "quick", false, "Serve ID, Names, Image, Labels, Ports, and State from a cached summary table instead of querying each container (cannot be combined with --size, --sync, --ns, or --pod)")
 	flags.Bool("noheading", false, "Do not print headers")
 	flags.BoolVarP(&listOpts.Size, "size", "s", false, "Display the total file sizes")
 	flags.BoolVar(&listOpts.Sync, "sync", false, "Sync container state with OCI runtime")
@@ -103,6 +112,10 @@ func listFlagSet(cmd *cobra.Command) {
 	flags.Var(sort, sortFlagName, "Sort output by: "+sort.Choices())
 	_ = cmd.RegisterFlagCompletionFunc(sortFlagName, common.AutocompletePsSort)
 
+	connectionsFlagName := "connections"
+	flags.StringSliceVar(&connections, connectionsFlagName, nil, "Fan this command out to multiple named connections and merge the results")
+	_ = cmd.RegisterFlagCompletionFunc(connectionsFlagName, common.AutocompleteSystemConnections)
+
 	flags.SetNormalizeFunc(utils.AliasFlags)
 }
 func checkFlags(c *cobra.Command) error {
@@ -110,6 +123,12 @@ func checkFlags(c *cobra.Command) error {
 	if listOpts.Last >= 0 && listOpts.Latest {
 		return errors.Errorf("last and latest are mutually exclusive")
 	}
+	if listOpts.Offset < 0 {
+		return errors.Errorf("offset cannot be negative")
+	}
+	if listOpts.Offset > 0 && listOpts.Latest {
+		return errors.Errorf("offset and latest are mutually exclusive")
+	}
 	// Quiet conflicts with size and namespace and is overridden by a Go
 	// template.
 	if listOpts.Quiet {
@@ -121,10 +140,23 @@ func checkFlags(c *cobra.Command) error {
 	if listOpts.Size && listOpts.Namespace {
 		return errors.Errorf("size and namespace options conflict")
 	}
+	// Quick serves reduced information straight from the summary table and
+	// cannot honor options that require inspecting the container itself.
+	if listOpts.Quick {
+		if listOpts.Size || listOpts.Namespace || listOpts.Sync || listOpts.Pod {
+			return errors.Errorf("quick conflicts with size, namespace, sync, and pod")
+		}
+	}
 
 	if listOpts.Watch > 0 && listOpts.Latest {
 		return errors.New("the watch and latest flags cannot be used together")
 	}
+	if len(connections) > 0 && !registry.IsRemote() {
+		return errors.New("--connections is only supported with the remote client")
+	}
+	if listOpts.Quick && registry.IsRemote() {
+		return errors.New("--quick is not supported with the remote client")
+	}
 	cfg := registry.PodmanConfig()
 	if cfg.Engine.Namespace != "" {
 		if c.Flag("storage").Changed && listOpts.External {
@@ -171,7 +203,15 @@ func quietOut(responses []entities.ListContainer) error {
 }
 
 func getResponses() ([]entities.ListContainer, error) {
-	responses, err := registry.ContainerEngine().ContainerList(registry.GetContext(), listOpts)
+	var (
+		responses []entities.ListContainer
+		err       error
+	)
+	if len(connections) > 0 {
+		responses, err = fanOutContainerList(connections)
+	} else {
+		responses, err = registry.ContainerEngine().ContainerList(registry.GetContext(), listOpts)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -184,6 +224,55 @@ func getResponses() ([]entities.ListContainer, error) {
 	return responses, nil
 }
 
+// fanOutContainerList runs ContainerList concurrently against each named
+// connection and merges the results, tagging every container with the
+// connection it came from so it can be told apart in the output.
+func fanOutContainerList(names []string) ([]entities.ListContainer, error) {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		merged   []entities.ListContainer
+	)
+	for _, name := range names {
+		name := name
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, err := bindings.NewConnectionByName(registry.GetContext(), name)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = errors.Wrapf(err, "connection %q", name)
+				}
+				mu.Unlock()
+				return
+			}
+			engine := tunnel.ContainerEngine{ClientCtx: ctx}
+			cons, err := engine.ContainerList(ctx, listOpts)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = errors.Wrapf(err, "connection %q", name)
+				}
+				mu.Unlock()
+				return
+			}
+			for i := range cons {
+				cons[i].Server = name
+			}
+			mu.Lock()
+			merged = append(merged, cons...)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return merged, nil
+}
+
 func ps(cmd *cobra.Command, _ []string) error {
 	if err := checkFlags(cmd); err != nil {
 		return err
@@ -318,6 +407,10 @@ func createPsOut() ([]map[string]string, string) {
 		if listOpts.Size {
 			row += "\t{{.Size}}"
 		}
+
+		if len(connections) > 0 {
+			row += "\t{{.Server}}"
+		}
 	}
 	return hdrs, "{{range .}}" + row + "\n{{end -}}"
 }
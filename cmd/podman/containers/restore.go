@@ -63,6 +63,10 @@ func init() {
 	flags.StringVarP(&restoreOptions.Name, nameFlagName, "n", "", "Specify new name for container restored from exported checkpoint (only works with --import)")
 	_ = restoreCommand.RegisterFlagCompletionFunc(nameFlagName, completion.AutocompleteNone)
 
+	importImageFlagName := "import-image"
+	flags.StringVar(&restoreOptions.ImportImage, importImageFlagName, "", "Restore from checkpoint image created with 'podman container checkpoint --create-image'")
+	_ = restoreCommand.RegisterFlagCompletionFunc(importImageFlagName, common.AutocompleteImages)
+
 	importPreviousFlagName := "import-previous"
 	flags.StringVar(&restoreOptions.ImportPrevious, importPreviousFlagName, "", "Restore from exported pre-checkpoint archive (tar.gz)")
 	_ = restoreCommand.RegisterFlagCompletionFunc(importPreviousFlagName, completion.AutocompleteDefault)
@@ -88,6 +92,8 @@ func init() {
 		"Display restore statistics",
 	)
 
+	flags.BoolVar(&restoreOptions.Precheck, "precheck", false, "Check if the image, networks and named volumes required by the checkpoint are available without restoring")
+
 	validate.AddLatestFlag(restoreCommand, &restoreOptions.Latest)
 }
 
@@ -97,24 +103,31 @@ func restore(cmd *cobra.Command, args []string) error {
 	if rootless.IsRootless() {
 		return errors.New("restoring a container requires root")
 	}
-	if restoreOptions.Import == "" && restoreOptions.ImportPrevious != "" {
+	if restoreOptions.Import != "" && restoreOptions.ImportImage != "" {
+		return errors.Errorf("--import and --import-image cannot be used together")
+	}
+	fromArchiveOrImage := restoreOptions.Import != "" || restoreOptions.ImportImage != ""
+	if !fromArchiveOrImage && restoreOptions.ImportPrevious != "" {
 		return errors.Errorf("--import-previous can only be used with --import")
 	}
-	if restoreOptions.Import == "" && restoreOptions.IgnoreRootFS {
-		return errors.Errorf("--ignore-rootfs can only be used with --import")
+	if !fromArchiveOrImage && restoreOptions.IgnoreRootFS {
+		return errors.Errorf("--ignore-rootfs can only be used with --import or --import-image")
 	}
-	if restoreOptions.Import == "" && restoreOptions.IgnoreVolumes {
-		return errors.Errorf("--ignore-volumes can only be used with --import")
+	if !fromArchiveOrImage && restoreOptions.IgnoreVolumes {
+		return errors.Errorf("--ignore-volumes can only be used with --import or --import-image")
 	}
-	if restoreOptions.Import == "" && restoreOptions.Name != "" {
-		return errors.Errorf("--name can only be used with --import")
+	if !fromArchiveOrImage && restoreOptions.Name != "" {
+		return errors.Errorf("--name can only be used with --import or --import-image")
 	}
-	if restoreOptions.Import == "" && restoreOptions.Pod != "" {
-		return errors.Errorf("--pod can only be used with --import")
+	if !fromArchiveOrImage && restoreOptions.Pod != "" {
+		return errors.Errorf("--pod can only be used with --import or --import-image")
 	}
 	if restoreOptions.Name != "" && restoreOptions.TCPEstablished {
 		return errors.Errorf("--tcp-established cannot be used with --name")
 	}
+	if restoreOptions.Precheck && !fromArchiveOrImage {
+		return errors.Errorf("--precheck can only be used with --import or --import-image")
+	}
 
 	inputPorts, err := cmd.Flags().GetStringSlice("publish")
 	if err != nil {
@@ -123,24 +136,40 @@ func restore(cmd *cobra.Command, args []string) error {
 	restoreOptions.PublishPorts = inputPorts
 
 	argLen := len(args)
-	if restoreOptions.Import != "" {
+	if fromArchiveOrImage {
 		if restoreOptions.All || restoreOptions.Latest {
-			return errors.Errorf("Cannot use --import with --all or --latest")
+			return errors.Errorf("Cannot use --import or --import-image with --all or --latest")
 		}
 		if argLen > 0 {
-			return errors.Errorf("Cannot use --import with positional arguments")
+			return errors.Errorf("Cannot use --import or --import-image with positional arguments")
 		}
 	}
 	if (restoreOptions.All || restoreOptions.Latest) && argLen > 0 {
 		return errors.Errorf("--all or --latest and containers cannot be used together")
 	}
-	if argLen < 1 && !restoreOptions.All && !restoreOptions.Latest && restoreOptions.Import == "" {
+	if argLen < 1 && !restoreOptions.All && !restoreOptions.Latest && !fromArchiveOrImage {
 		return errors.Errorf("you must provide at least one name or id")
 	}
 	responses, err := registry.ContainerEngine().ContainerRestore(context.Background(), args, restoreOptions)
 	if err != nil {
 		return err
 	}
+
+	if restoreOptions.Precheck {
+		if len(responses) != 1 || responses[0].PrecheckReport == nil {
+			return errors.New("expected a single precheck report")
+		}
+		report := responses[0].PrecheckReport
+		j, err := json.MarshalIndent(report, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(j))
+		if !report.OK() {
+			return errors.New("checkpoint precheck failed, see report above")
+		}
+		return nil
+	}
 	podmanFinished := time.Now()
 
 	var statistics restoreStatistics
@@ -0,0 +1,111 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/utils"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	updateDescription = `Update the device cgroup rules and block IO limits of one or more running containers, without a restart.`
+	updateCommand     = &cobra.Command{
+		Use:               "update [options] CONTAINER [CONTAINER...]",
+		Short:             "Update the cgroup configuration of one or more containers",
+		Long:              updateDescription,
+		RunE:              update,
+		ValidArgsFunction: common.AutocompleteContainersRunning,
+		Example: `podman update --device-cgroup-rule="c 42:* rwm" mywebserver
+  podman update --blkio-weight 300 860a4b23
+  podman update --device-write-bps=/dev/sda:1mb 860a4b23`,
+	}
+
+	containerUpdateCommand = &cobra.Command{
+		Use:               updateCommand.Use,
+		Short:             updateCommand.Short,
+		Long:              updateCommand.Long,
+		RunE:              updateCommand.RunE,
+		ValidArgsFunction: updateCommand.ValidArgsFunction,
+		Example: `podman container update --device-cgroup-rule="c 42:* rwm" mywebserver
+  podman container update --blkio-weight 300 860a4b23
+  podman container update --device-write-bps=/dev/sda:1mb 860a4b23`,
+	}
+
+	updateOpts = entities.ContainerUpdateOptions{}
+)
+
+func updateFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	deviceCgroupRuleFlagName := "device-cgroup-rule"
+	flags.StringArrayVar(&updateOpts.DeviceCgroupRule, deviceCgroupRuleFlagName, nil, "Add a rule to the effective device cgroup rule set")
+	_ = cmd.RegisterFlagCompletionFunc(deviceCgroupRuleFlagName, completion.AutocompleteNone)
+
+	blkioWeightFlagName := "blkio-weight"
+	flags.StringVar(&updateOpts.BlkIOWeight, blkioWeightFlagName, "", "Block IO relative weight, between 1 and 10000")
+	_ = cmd.RegisterFlagCompletionFunc(blkioWeightFlagName, completion.AutocompleteNone)
+
+	blkioWeightDeviceFlagName := "blkio-weight-device"
+	flags.StringArrayVar(&updateOpts.BlkIOWeightDevice, blkioWeightDeviceFlagName, nil, "Block IO relative device weight (e.g. --blkio-weight-device=/dev/sda:1000)")
+	_ = cmd.RegisterFlagCompletionFunc(blkioWeightDeviceFlagName, completion.AutocompleteDefault)
+
+	deviceReadBpsFlagName := "device-read-bps"
+	flags.StringArrayVar(&updateOpts.DeviceReadBPs, deviceReadBpsFlagName, nil, "Limit read rate (bytes per second) from a device (e.g. --device-read-bps=/dev/sda:1mb)")
+	_ = cmd.RegisterFlagCompletionFunc(deviceReadBpsFlagName, completion.AutocompleteDefault)
+
+	deviceWriteBpsFlagName := "device-write-bps"
+	flags.StringArrayVar(&updateOpts.DeviceWriteBPs, deviceWriteBpsFlagName, nil, "Limit write rate (bytes per second) to a device (e.g. --device-write-bps=/dev/sda:1mb)")
+	_ = cmd.RegisterFlagCompletionFunc(deviceWriteBpsFlagName, completion.AutocompleteDefault)
+
+	deviceReadIopsFlagName := "device-read-iops"
+	flags.StringArrayVar(&updateOpts.DeviceReadIOPs, deviceReadIopsFlagName, nil, "Limit read rate (IO per second) from a device (e.g. --device-read-iops=/dev/sda:1000)")
+	_ = cmd.RegisterFlagCompletionFunc(deviceReadIopsFlagName, completion.AutocompleteDefault)
+
+	deviceWriteIopsFlagName := "device-write-iops"
+	flags.StringArrayVar(&updateOpts.DeviceWriteIOPs, deviceWriteIopsFlagName, nil, "Limit write rate (IO per second) to a device (e.g. --device-write-iops=/dev/sda:1000)")
+	_ = cmd.RegisterFlagCompletionFunc(deviceWriteIopsFlagName, completion.AutocompleteDefault)
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: updateCommand,
+	})
+	updateFlags(updateCommand)
+
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: containerUpdateCommand,
+		Parent:  containerCmd,
+	})
+	updateFlags(containerUpdateCommand)
+}
+
+func update(cmd *cobra.Command, args []string) error {
+	var errs utils.OutputErrors
+
+	if len(args) < 1 {
+		return errors.Errorf("you must provide at least one container name or id")
+	}
+	if len(updateOpts.DeviceCgroupRule) == 0 && updateOpts.BlkIOWeight == "" && len(updateOpts.BlkIOWeightDevice) == 0 &&
+		len(updateOpts.DeviceReadBPs) == 0 && len(updateOpts.DeviceWriteBPs) == 0 &&
+		len(updateOpts.DeviceReadIOPs) == 0 && len(updateOpts.DeviceWriteIOPs) == 0 {
+		return errors.New("you must provide at least one option to update")
+	}
+
+	responses, err := registry.ContainerEngine().ContainerUpdate(context.Background(), args, updateOpts)
+	if err != nil {
+		return err
+	}
+	for _, r := range responses {
+		if r.Err == nil {
+			fmt.Println(r.Id)
+		} else {
+			errs = append(errs, r.Err)
+		}
+	}
+	return errs.PrintErrors()
+}
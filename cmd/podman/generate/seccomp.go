@@ -0,0 +1,65 @@
+package pods
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	seccompOptions     = entities.GenerateSeccompOptions{}
+	seccompFile        = ""
+	seccompDescription = `Command generates a minimal seccomp profile for a container from the syscalls it was observed making.
+
+  The container must have been started with "podman run --seccomp-trace" so that its syscalls were recorded.`
+
+	seccompCmd = &cobra.Command{
+		Use:   "seccomp [options] CONTAINER",
+		Short: "Generate a seccomp profile from a container's observed syscalls.",
+		Long:  seccompDescription,
+		RunE:  seccomp,
+		Args:  cobra.ExactArgs(1),
+		Example: `podman generate seccomp ctrID
+  podman generate seccomp --filename seccomp.json ctrID`,
+		ValidArgsFunction: common.AutocompleteContainers,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: seccompCmd,
+		Parent:  generateCmd,
+	})
+	flags := seccompCmd.Flags()
+
+	filenameFlagName := "filename"
+	flags.StringVarP(&seccompFile, filenameFlagName, "f", "", "Write output to the specified path")
+	_ = seccompCmd.RegisterFlagCompletionFunc(filenameFlagName, completion.AutocompleteDefault)
+}
+
+func seccomp(cmd *cobra.Command, args []string) error {
+	report, err := registry.ContainerEngine().GenerateSeccomp(registry.GetContext(), args[0], seccompOptions)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("filename") {
+		if _, err := os.Stat(seccompFile); err == nil {
+			return errors.Errorf("cannot write to %q; file exists", seccompFile)
+		}
+		if err := ioutil.WriteFile(seccompFile, []byte(report.Seccomp), 0644); err != nil {
+			return errors.Wrapf(err, "cannot write to %q", seccompFile)
+		}
+		return nil
+	}
+
+	fmt.Println(report.Seccomp)
+	return nil
+}
@@ -3,7 +3,9 @@ package pods
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 
 	"github.com/containers/common/pkg/completion"
@@ -12,6 +14,7 @@ import (
 	"github.com/containers/podman/v4/cmd/podman/registry"
 	"github.com/containers/podman/v4/cmd/podman/utils"
 	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/containers/podman/v4/pkg/rootless"
 	systemDefine "github.com/containers/podman/v4/pkg/systemd/define"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -28,10 +31,15 @@ const (
 	wantsFlagName             = "wants"
 	afterFlagName             = "after"
 	requiresFlagName          = "requires"
+	dropinFlagName            = "dropin"
+	installFlagName           = "install"
+	socketActivatedFlagName   = "socket-activated"
 )
 
 var (
 	files              bool
+	install            bool
+	dropins            []string
 	format             string
 	systemdRestart     string
 	systemdRestartSec  uint
@@ -109,6 +117,13 @@ func init() {
 	flags.StringArrayVar(&systemdOptions.Requires, requiresFlagName, nil, "Similar to wants, but declares stronger requirement dependencies")
 	_ = systemdCmd.RegisterFlagCompletionFunc(requiresFlagName, completion.AutocompleteNone)
 
+	flags.StringArrayVar(&dropins, dropinFlagName, nil, "Path to a drop-in config file whose content is appended to the generated unit")
+	_ = systemdCmd.RegisterFlagCompletionFunc(dropinFlagName, completion.AutocompleteDefault)
+
+	flags.BoolVar(&install, installFlagName, false, "Write generated units to the correct systemd unit directory and run daemon-reload (implies --files)")
+
+	flags.BoolVar(&systemdOptions.SocketActivated, socketActivatedFlagName, false, "Omit the [Install] section, for units meant to be started on demand by a matching .socket unit")
+
 	flags.SetNormalizeFunc(utils.TimeoutAliasFlags)
 }
 
@@ -148,18 +163,33 @@ func systemd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("%s and %s are redundant and cannot be used together", stopTimeoutFlagName, stopTimeoutCompatFlagName)
 	}
 
+	for _, dropin := range dropins {
+		content, err := ioutil.ReadFile(dropin)
+		if err != nil {
+			return errors.Wrapf(err, "reading drop-in file %q", dropin)
+		}
+		systemdOptions.AdditionalConfig = append(systemdOptions.AdditionalConfig, string(content))
+	}
+
+	if install && registry.IsRemote() {
+		return errors.New("--install is not supported on the remote client")
+	}
+	if install {
+		files = true
+	}
+
 	reports, err := registry.ContainerEngine().GenerateSystemd(registry.GetContext(), args[0], systemdOptions)
 	if err != nil {
 		return err
 	}
 
 	if files {
-		cwd, err := os.Getwd()
+		targetDir, err := unitOutputDir(install)
 		if err != nil {
-			return errors.Wrap(err, "error getting current working directory")
+			return err
 		}
 		for name, content := range reports.Units {
-			path := filepath.Join(cwd, fmt.Sprintf("%s.service", name))
+			path := filepath.Join(targetDir, fmt.Sprintf("%s.service", name))
 			f, err := os.Create(path)
 			if err != nil {
 				return err
@@ -183,6 +213,12 @@ func systemd(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if install {
+		if err := daemonReload(); err != nil {
+			return err
+		}
+	}
+
 	switch {
 	case report.IsJSON(format):
 		return printJSON(reports.Units)
@@ -208,3 +244,38 @@ func printJSON(units map[string]string) error {
 	fmt.Println(string(b))
 	return nil
 }
+
+// unitOutputDir returns the directory the generated unit files should be
+// written to: the current working directory, unless install is set, in
+// which case it is the user's or system's systemd unit directory depending
+// on whether podman is running rootless.
+func unitOutputDir(install bool) (string, error) {
+	if !install {
+		return os.Getwd()
+	}
+	if rootless.IsRootless() {
+		configHome, err := os.UserConfigDir()
+		if err != nil {
+			return "", errors.Wrap(err, "getting user config directory")
+		}
+		dir := filepath.Join(configHome, "systemd", "user")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return "", err
+		}
+		return dir, nil
+	}
+	return "/etc/systemd/system", nil
+}
+
+// daemonReload runs "systemctl daemon-reload" (or its --user equivalent for
+// a rootless install) so the newly installed units are picked up.
+func daemonReload() error {
+	args := []string{"daemon-reload"}
+	if rootless.IsRootless() {
+		args = append([]string{"--user"}, args...)
+	}
+	cmd := exec.Command("systemctl", args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -106,6 +106,14 @@ func imageListFlagSet(cmd *cobra.Command) {
 	_ = cmd.RegisterFlagCompletionFunc(sortFlagName, completion.AutocompleteNone)
 
 	flags.BoolVarP(&listFlag.history, "history", "", false, "Display the image name history")
+
+	limitFlagName := "limit"
+	flags.IntVar(&listOptions.Limit, limitFlagName, 0, "Limit the number of images returned, newest first. Use 0 for no limit")
+	_ = cmd.RegisterFlagCompletionFunc(limitFlagName, completion.AutocompleteNone)
+
+	offsetFlagName := "offset"
+	flags.IntVar(&listOptions.Offset, offsetFlagName, 0, "Skip the first n newest images before applying --limit")
+	_ = cmd.RegisterFlagCompletionFunc(offsetFlagName, completion.AutocompleteNone)
 }
 
 func images(cmd *cobra.Command, args []string) error {
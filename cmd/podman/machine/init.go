@@ -101,6 +101,14 @@ func init() {
 	flags.StringVar(&initOpts.IgnitionPath, IgnitionPathFlagName, "", "Path to ignition file")
 	_ = initCmd.RegisterFlagCompletionFunc(IgnitionPathFlagName, completion.AutocompleteDefault)
 
+	CloudInitFlagName := "cloud-init"
+	flags.StringVar(&initOpts.CloudInit, CloudInitFlagName, "", "Path to a cloud-init user-data file to embed in the machine")
+	_ = initCmd.RegisterFlagCompletionFunc(CloudInitFlagName, completion.AutocompleteDefault)
+
+	CACertsPathFlagName := "ca-certs-path"
+	flags.StringVar(&initOpts.CACertsPath, CACertsPathFlagName, "", "Path to a PEM file of additional CA certificates to trust in the machine, re-installed on every start")
+	_ = initCmd.RegisterFlagCompletionFunc(CACertsPathFlagName, completion.AutocompleteDefault)
+
 	rootfulFlagName := "rootful"
 	flags.BoolVar(&initOpts.Rootful, rootfulFlagName, false, "Whether this machine should prefer rootful container exectution")
 }
@@ -112,6 +120,10 @@ func initMachine(cmd *cobra.Command, args []string) error {
 		err error
 	)
 
+	if initOpts.CloudInit != "" && initOpts.IgnitionPath != "" {
+		return errors.New("cannot use --cloud-init and --ignition-path at the same time")
+	}
+
 	provider := getSystemDefaultProvider()
 	initOpts.Name = defaultMachineName
 	if len(args) > 0 {
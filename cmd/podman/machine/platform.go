@@ -4,10 +4,23 @@
 package machine
 
 import (
+	"os"
+
 	"github.com/containers/podman/v4/pkg/machine"
+	"github.com/containers/podman/v4/pkg/machine/libvirt"
 	"github.com/containers/podman/v4/pkg/machine/qemu"
 )
 
+// machineProviderEnvVar overrides the machine backend podman drives, e.g.
+// "libvirt" to manage machines through libvirt instead of talking to qemu
+// directly. Defaults to qemu.
+const machineProviderEnvVar = "CONTAINERS_MACHINE_PROVIDER"
+
 func getSystemDefaultProvider() machine.Provider {
-	return qemu.GetQemuProvider()
+	switch os.Getenv(machineProviderEnvVar) {
+	case "libvirt":
+		return libvirt.GetLibvirtProvider()
+	default:
+		return qemu.GetQemuProvider()
+	}
 }
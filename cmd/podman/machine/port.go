@@ -0,0 +1,27 @@
+//go:build amd64 || arm64
+// +build amd64 arm64
+
+package machine
+
+import (
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command: podman machine _port_
+	portCmd = &cobra.Command{
+		Use:   "port",
+		Short: "Manage a machine's port forwards",
+		Long:  "Add, list or remove additional host-to-guest port forwards on a stopped machine",
+		RunE:  validate.SubCommandExists,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: portCmd,
+		Parent:  machineCmd,
+	})
+}
@@ -0,0 +1,101 @@
+//go:build amd64 || arm64
+// +build amd64 arm64
+
+package machine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/machine"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	portAddCmd = &cobra.Command{
+		Use:   "add [options] PORT [NAME]",
+		Short: "Add a port forward to a machine",
+		Long: `Add an additional host-to-guest port forward, in
+[host_ip:]host_port:guest_port[/protocol] form, to a stopped machine`,
+		RunE:              portAdd,
+		Args:              cobra.RangeArgs(1, 2),
+		Example:           `podman machine port add 8080:80`,
+		ValidArgsFunction: completion.AutocompleteNone,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: portAddCmd,
+		Parent:  portCmd,
+	})
+}
+
+func portAdd(cmd *cobra.Command, args []string) error {
+	vmName := defaultMachineName
+	if len(args) > 1 {
+		vmName = args[1]
+	}
+
+	mapping, err := parsePortMapping(args[0])
+	if err != nil {
+		return err
+	}
+
+	provider := getSystemDefaultProvider()
+	vm, err := provider.LoadVMByName(vmName)
+	if err != nil {
+		return err
+	}
+
+	if err := vm.AddPort(vmName, machine.PortAddOptions{Mapping: mapping}); err != nil {
+		return err
+	}
+	fmt.Printf("Port %q added\n", args[0])
+	return nil
+}
+
+// parsePortMapping parses a [host_ip:]host_port:guest_port[/protocol] spec,
+// the same syntax accepted by `podman machine port add`.
+func parsePortMapping(spec string) (machine.PortMapping, error) {
+	protocol := "tcp"
+	rest := spec
+	if idx := strings.LastIndex(spec, "/"); idx != -1 {
+		rest = spec[:idx]
+		protocol = spec[idx+1:]
+	}
+	if protocol != "tcp" && protocol != "udp" {
+		return machine.PortMapping{}, errors.Errorf("unknown protocol %q: must be tcp or udp", protocol)
+	}
+
+	parts := strings.Split(rest, ":")
+	var hostIP, hostPortStr, guestPortStr string
+	switch len(parts) {
+	case 2:
+		hostPortStr, guestPortStr = parts[0], parts[1]
+	case 3:
+		hostIP, hostPortStr, guestPortStr = parts[0], parts[1], parts[2]
+	default:
+		return machine.PortMapping{}, errors.Errorf("invalid port mapping %q: must be [host_ip:]host_port:guest_port[/protocol]", spec)
+	}
+
+	hostPort, err := strconv.ParseUint(hostPortStr, 10, 16)
+	if err != nil {
+		return machine.PortMapping{}, errors.Wrapf(err, "invalid host port %q", hostPortStr)
+	}
+	guestPort, err := strconv.ParseUint(guestPortStr, 10, 16)
+	if err != nil {
+		return machine.PortMapping{}, errors.Wrapf(err, "invalid guest port %q", guestPortStr)
+	}
+
+	return machine.PortMapping{
+		Protocol:  protocol,
+		HostIP:    hostIP,
+		HostPort:  uint16(hostPort),
+		GuestPort: uint16(guestPort),
+	}, nil
+}
@@ -0,0 +1,107 @@
+//go:build amd64 || arm64
+// +build amd64 arm64
+
+package machine
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/spf13/cobra"
+)
+
+var (
+	portListCmd = &cobra.Command{
+		Use:               "list [options] [NAME]",
+		Aliases:           []string{"ls"},
+		Short:             "List port forwards for a machine",
+		Long:              "List the additional host-to-guest port forwards configured on a machine",
+		RunE:              portList,
+		Args:              cobra.MaximumNArgs(1),
+		Example:           `podman machine port list`,
+		ValidArgsFunction: completion.AutocompleteNone,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: portListCmd,
+		Parent:  portCmd,
+	})
+	portListCmd.Flags().String("format", "", "Custom Go template for printing port forwards")
+	_ = portListCmd.RegisterFlagCompletionFunc("format", common.AutocompleteFormat(portReporter{}))
+}
+
+type portReporter struct {
+	HostIP    string
+	HostPort  uint16
+	GuestPort uint16
+	Protocol  string
+}
+
+func portList(cmd *cobra.Command, args []string) error {
+	vmName := defaultMachineName
+	if len(args) > 0 {
+		vmName = args[0]
+	}
+
+	provider := getSystemDefaultProvider()
+	vm, err := provider.LoadVMByName(vmName)
+	if err != nil {
+		return err
+	}
+
+	mappings, err := vm.ListPorts(vmName)
+	if err != nil {
+		return err
+	}
+
+	rows := make([]portReporter, 0, len(mappings))
+	for _, m := range mappings {
+		rows = append(rows, portReporter{
+			HostIP:    m.HostIP,
+			HostPort:  m.HostPort,
+			GuestPort: m.GuestPort,
+			Protocol:  m.Protocol,
+		})
+	}
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	if report.IsJSON(cmd.Flag("format").Value.String()) {
+		buf, err := registry.JSONLibrary().MarshalIndent(rows, "", "    ")
+		if err == nil {
+			fmt.Println(string(buf))
+		}
+		return err
+	}
+
+	if cmd.Flag("format").Changed {
+		rpt, err = rpt.Parse(report.OriginUser, cmd.Flag("format").Value.String())
+	} else {
+		rpt, err = rpt.Parse(report.OriginPodman,
+			"{{range .}}{{.HostIP}}\t{{.HostPort}}\t{{.GuestPort}}\t{{.Protocol}}\n{{end -}}")
+	}
+	if err != nil {
+		return err
+	}
+
+	if rpt.RenderHeaders {
+		err = rpt.Execute([]map[string]string{{
+			"HostIP":    "HOST IP",
+			"HostPort":  "HOST PORT",
+			"GuestPort": "GUEST PORT",
+			"Protocol":  "PROTOCOL",
+		}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return rpt.Execute(rows)
+}
@@ -0,0 +1,100 @@
+//go:build amd64 || arm64
+// +build amd64 arm64
+
+package machine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/machine"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	portRemoveOpts struct {
+		all bool
+	}
+	portRemoveCmd = &cobra.Command{
+		Use:     "remove [options] [PORT] [NAME]",
+		Aliases: []string{"rm"},
+		Short:   "Remove a port forward from a machine",
+		Long:    "Remove a port forward, identified by its host_port[/protocol], from a stopped machine",
+		RunE:    portRemove,
+		Args:    cobra.MaximumNArgs(2),
+		Example: `podman machine port remove 8080
+  podman machine port remove --all`,
+		ValidArgsFunction: completion.AutocompleteNone,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: portRemoveCmd,
+		Parent:  portCmd,
+	})
+	flags := portRemoveCmd.Flags()
+	flags.BoolVar(&portRemoveOpts.all, "all", false, "Remove all port forwards")
+}
+
+func portRemove(cmd *cobra.Command, args []string) error {
+	vmName := defaultMachineName
+	opts := machine.PortRemoveOptions{All: portRemoveOpts.all}
+
+	if portRemoveOpts.all {
+		if len(args) > 0 {
+			vmName = args[0]
+		}
+	} else {
+		if len(args) == 0 {
+			return errors.New("accepts 1 arg(s), received 0")
+		}
+		protocol, hostPort, err := parseHostPort(args[0])
+		if err != nil {
+			return err
+		}
+		opts.Mapping = machine.PortMapping{Protocol: protocol, HostPort: hostPort}
+		if len(args) > 1 {
+			vmName = args[1]
+		}
+	}
+
+	provider := getSystemDefaultProvider()
+	vm, err := provider.LoadVMByName(vmName)
+	if err != nil {
+		return err
+	}
+
+	if err := vm.RemovePort(vmName, opts); err != nil {
+		return err
+	}
+	if portRemoveOpts.all {
+		fmt.Println("All port forwards removed")
+	} else {
+		fmt.Printf("Port %q removed\n", args[0])
+	}
+	return nil
+}
+
+// parseHostPort parses a host_port[/protocol] spec, the syntax accepted by
+// `podman machine port remove`.
+func parseHostPort(spec string) (string, uint16, error) {
+	protocol := "tcp"
+	hostPortStr := spec
+	if idx := strings.LastIndex(spec, "/"); idx != -1 {
+		hostPortStr = spec[:idx]
+		protocol = spec[idx+1:]
+	}
+	if protocol != "tcp" && protocol != "udp" {
+		return "", 0, errors.Errorf("unknown protocol %q: must be tcp or udp", protocol)
+	}
+	hostPort, err := strconv.ParseUint(hostPortStr, 10, 16)
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "invalid host port %q", hostPortStr)
+	}
+	return protocol, uint16(hostPort), nil
+}
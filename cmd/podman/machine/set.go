@@ -12,12 +12,13 @@ import (
 
 var (
 	setCmd = &cobra.Command{
-		Use:               "set [options] [NAME]",
-		Short:             "Sets a virtual machine setting",
-		Long:              "Sets an updatable virtual machine setting",
-		RunE:              setMachine,
-		Args:              cobra.MaximumNArgs(1),
-		Example:           `podman machine set --root=false`,
+		Use:   "set [options] [NAME]",
+		Short: "Sets a virtual machine setting",
+		Long:  "Sets an updatable virtual machine setting",
+		RunE:  setMachine,
+		Args:  cobra.MaximumNArgs(1),
+		Example: `podman machine set --root=false
+  podman machine set --cpus 4 --memory 4096 --disk-size 100`,
 		ValidArgsFunction: completion.AutocompleteNone,
 	}
 )
@@ -35,6 +36,18 @@ func init() {
 
 	rootfulFlagName := "rootful"
 	flags.BoolVar(&setOpts.Rootful, rootfulFlagName, false, "Whether this machine should prefer rootful container execution")
+
+	cpusFlagName := "cpus"
+	flags.Uint64Var(&setOpts.CPUs, cpusFlagName, 0, "Number of CPUs")
+	_ = setCmd.RegisterFlagCompletionFunc(cpusFlagName, completion.AutocompleteNone)
+
+	memoryFlagName := "memory"
+	flags.Uint64VarP(&setOpts.Memory, memoryFlagName, "m", 0, "Memory in MB")
+	_ = setCmd.RegisterFlagCompletionFunc(memoryFlagName, completion.AutocompleteNone)
+
+	diskSizeFlagName := "disk-size"
+	flags.Uint64Var(&setOpts.DiskSize, diskSizeFlagName, 0, "Disk size in GB. Can only be increased")
+	_ = setCmd.RegisterFlagCompletionFunc(diskSizeFlagName, completion.AutocompleteNone)
 }
 
 func setMachine(cmd *cobra.Command, args []string) error {
@@ -0,0 +1,27 @@
+//go:build amd64 || arm64
+// +build amd64 arm64
+
+package machine
+
+import (
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command: podman machine _snapshot_
+	snapshotCmd = &cobra.Command{
+		Use:   "snapshot",
+		Short: "Manage a machine's disk snapshots",
+		Long:  "Create, list or restore internal disk-image snapshots of a stopped machine",
+		RunE:  validate.SubCommandExists,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: snapshotCmd,
+		Parent:  machineCmd,
+	})
+}
@@ -0,0 +1,51 @@
+//go:build amd64 || arm64
+// +build amd64 arm64
+
+package machine
+
+import (
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/machine"
+	"github.com/spf13/cobra"
+)
+
+var (
+	snapshotCreateCmd = &cobra.Command{
+		Use:               "create [options] TAG [NAME]",
+		Short:             "Create a machine snapshot",
+		Long:              "Take an internal disk-image snapshot, identified by TAG, of a stopped machine",
+		RunE:              snapshotCreate,
+		Args:              cobra.RangeArgs(1, 2),
+		Example:           `podman machine snapshot create clean-install`,
+		ValidArgsFunction: completion.AutocompleteNone,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: snapshotCreateCmd,
+		Parent:  snapshotCmd,
+	})
+}
+
+func snapshotCreate(cmd *cobra.Command, args []string) error {
+	vmName := defaultMachineName
+	if len(args) > 1 {
+		vmName = args[1]
+	}
+
+	provider := getSystemDefaultProvider()
+	vm, err := provider.LoadVMByName(vmName)
+	if err != nil {
+		return err
+	}
+
+	if err := vm.CreateSnapshot(vmName, machine.SnapshotCreateOptions{Name: args[0]}); err != nil {
+		return err
+	}
+	fmt.Printf("Snapshot %q created\n", args[0])
+	return nil
+}
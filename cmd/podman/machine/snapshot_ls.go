@@ -0,0 +1,54 @@
+//go:build amd64 || arm64
+// +build amd64 arm64
+
+package machine
+
+import (
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/spf13/cobra"
+)
+
+var (
+	snapshotListCmd = &cobra.Command{
+		Use:               "list [options] [NAME]",
+		Aliases:           []string{"ls"},
+		Short:             "List machine snapshots",
+		Long:              "List the internal disk-image snapshots taken of a machine",
+		RunE:              snapshotList,
+		Args:              cobra.MaximumNArgs(1),
+		Example:           `podman machine snapshot list`,
+		ValidArgsFunction: completion.AutocompleteNone,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: snapshotListCmd,
+		Parent:  snapshotCmd,
+	})
+}
+
+func snapshotList(cmd *cobra.Command, args []string) error {
+	vmName := defaultMachineName
+	if len(args) > 0 {
+		vmName = args[0]
+	}
+
+	provider := getSystemDefaultProvider()
+	vm, err := provider.LoadVMByName(vmName)
+	if err != nil {
+		return err
+	}
+
+	tags, err := vm.ListSnapshots(vmName)
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		fmt.Println(tag)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+//go:build amd64 || arm64
+// +build amd64 arm64
+
+package machine
+
+import (
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/machine"
+	"github.com/spf13/cobra"
+)
+
+var (
+	snapshotRestoreCmd = &cobra.Command{
+		Use:               "restore [options] TAG [NAME]",
+		Short:             "Restore a machine snapshot",
+		Long:              "Roll a stopped machine's disk image back to a snapshot previously taken with 'podman machine snapshot create'",
+		RunE:              snapshotRestore,
+		Args:              cobra.RangeArgs(1, 2),
+		Example:           `podman machine snapshot restore clean-install`,
+		ValidArgsFunction: completion.AutocompleteNone,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: snapshotRestoreCmd,
+		Parent:  snapshotCmd,
+	})
+}
+
+func snapshotRestore(cmd *cobra.Command, args []string) error {
+	vmName := defaultMachineName
+	if len(args) > 1 {
+		vmName = args[1]
+	}
+
+	provider := getSystemDefaultProvider()
+	vm, err := provider.LoadVMByName(vmName)
+	if err != nil {
+		return err
+	}
+
+	if err := vm.RestoreSnapshot(vmName, machine.SnapshotRestoreOptions{Name: args[0]}); err != nil {
+		return err
+	}
+	fmt.Printf("Snapshot %q restored\n", args[0])
+	return nil
+}
@@ -0,0 +1,51 @@
+//go:build amd64 || arm64
+// +build amd64 arm64
+
+package machine
+
+import (
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/machine"
+	"github.com/spf13/cobra"
+)
+
+var (
+	volumeAddCmd = &cobra.Command{
+		Use:               "add [options] VOLUME [NAME]",
+		Short:             "Add a volume mount to a machine",
+		Long:              "Add a volume mount, in host:target[:opts] form, to a stopped machine",
+		RunE:              volumeAdd,
+		Args:              cobra.RangeArgs(1, 2),
+		Example:           `podman machine volume add $HOME/shared:/mnt/shared`,
+		ValidArgsFunction: completion.AutocompleteNone,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: volumeAddCmd,
+		Parent:  volumeCmd,
+	})
+}
+
+func volumeAdd(cmd *cobra.Command, args []string) error {
+	vmName := defaultMachineName
+	if len(args) > 1 {
+		vmName = args[1]
+	}
+
+	provider := getSystemDefaultProvider()
+	vm, err := provider.LoadVMByName(vmName)
+	if err != nil {
+		return err
+	}
+
+	if err := vm.AddVolume(vmName, machine.VolumeAddOptions{Volume: args[0]}); err != nil {
+		return err
+	}
+	fmt.Printf("Volume %q added\n", args[0])
+	return nil
+}
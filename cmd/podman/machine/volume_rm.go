@@ -0,0 +1,52 @@
+//go:build amd64 || arm64
+// +build amd64 arm64
+
+package machine
+
+import (
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/machine"
+	"github.com/spf13/cobra"
+)
+
+var (
+	volumeRemoveCmd = &cobra.Command{
+		Use:               "remove [options] TAG [NAME]",
+		Aliases:           []string{"rm"},
+		Short:             "Remove a volume mount from a machine",
+		Long:              "Remove a volume mount, identified by its mount tag (see 'podman machine inspect'), from a stopped machine",
+		RunE:              volumeRemove,
+		Args:              cobra.RangeArgs(1, 2),
+		Example:           `podman machine volume remove vol0`,
+		ValidArgsFunction: completion.AutocompleteNone,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: volumeRemoveCmd,
+		Parent:  volumeCmd,
+	})
+}
+
+func volumeRemove(cmd *cobra.Command, args []string) error {
+	vmName := defaultMachineName
+	if len(args) > 1 {
+		vmName = args[1]
+	}
+
+	provider := getSystemDefaultProvider()
+	vm, err := provider.LoadVMByName(vmName)
+	if err != nil {
+		return err
+	}
+
+	if err := vm.RemoveVolume(vmName, machine.VolumeRemoveOptions{Tag: args[0]}); err != nil {
+		return err
+	}
+	fmt.Printf("Volume %q removed\n", args[0])
+	return nil
+}
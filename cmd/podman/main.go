@@ -18,7 +18,9 @@ import (
 	"github.com/containers/podman/v4/cmd/podman/registry"
 	_ "github.com/containers/podman/v4/cmd/podman/secrets"
 	_ "github.com/containers/podman/v4/cmd/podman/system"
+	_ "github.com/containers/podman/v4/cmd/podman/system/cdi"
 	_ "github.com/containers/podman/v4/cmd/podman/system/connection"
+	_ "github.com/containers/podman/v4/cmd/podman/systemd"
 	_ "github.com/containers/podman/v4/cmd/podman/volumes"
 	"github.com/containers/podman/v4/pkg/rootless"
 	"github.com/containers/podman/v4/pkg/terminal"
@@ -0,0 +1,50 @@
+package network
+
+import (
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	networkUpdateDescription = `Update network-scoped DNS aliases for a container already connected to a network, without restarting it`
+	networkUpdateCommand     = &cobra.Command{
+		Use:               "update [options] NETWORK CONTAINER",
+		Short:             "network update",
+		Long:              networkUpdateDescription,
+		RunE:              networkUpdate,
+		Example:           `podman network update web --add-alias new secondary`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: common.AutocompleteNetworkConnectCmd,
+	}
+)
+
+var (
+	networkUpdateOptions entities.NetworkUpdateOptions
+)
+
+func networkUpdateFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	addAliasFlagName := "add-alias"
+	flags.StringSliceVar(&networkUpdateOptions.AddAliases, addAliasFlagName, nil, "network scoped alias to add for the container")
+	_ = cmd.RegisterFlagCompletionFunc(addAliasFlagName, completion.AutocompleteNone)
+
+	removeAliasFlagName := "remove-alias"
+	flags.StringSliceVar(&networkUpdateOptions.RemoveAliases, removeAliasFlagName, nil, "network scoped alias to remove from the container")
+	_ = cmd.RegisterFlagCompletionFunc(removeAliasFlagName, completion.AutocompleteNone)
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: networkUpdateCommand,
+		Parent:  networkCmd,
+	})
+	networkUpdateFlags(networkUpdateCommand)
+}
+
+func networkUpdate(cmd *cobra.Command, args []string) error {
+	networkUpdateOptions.Container = args[1]
+	return registry.ContainerEngine().NetworkUpdate(registry.Context(), args[0], networkUpdateOptions)
+}
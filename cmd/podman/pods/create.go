@@ -93,6 +93,27 @@ func init() {
 	shareParentFlagName := "share-parent"
 	flags.BoolVar(&shareParent, shareParentFlagName, true, "Set the pod's cgroup as the cgroup parent for all containers joining the pod")
 
+	memoryFlagName := "memory"
+	flags.StringVarP(&createOptions.Memory, memoryFlagName, "m", "", "Memory limit enforced on the pod's cgroup, bounding all containers in the pod")
+	_ = createCommand.RegisterFlagCompletionFunc(memoryFlagName, completion.AutocompleteNone)
+
+	pidsLimitFlagName := "pids-limit"
+	flags.Int64Var(&createOptions.PidsLimit, pidsLimitFlagName, 0, "Tune the pod's PIDs limit, bounding all containers in the pod (set 0 for unlimited)")
+	_ = createCommand.RegisterFlagCompletionFunc(pidsLimitFlagName, completion.AutocompleteNone)
+
+	restartFlagName := "restart"
+	flags.StringVar(&createOptions.Restart, restartFlagName, "", `Restart policy applied to the pod's infra container, governing infra (and thus pod) recreation ("always"|"no"|"on-failure")`)
+	_ = createCommand.RegisterFlagCompletionFunc(restartFlagName, common.AutocompleteRestartOption)
+
+	// Named volumes given to the infra container's own --volume flag (defined
+	// by common.DefineCreateFlags above) are also shared with every container
+	// joined to the pod; see addPodResources in pkg/specgen/generate. Bind
+	// mounts and overlay volumes from that same flag stay infra-container-only.
+
+	secretFlagName := "secret"
+	flags.StringArrayVar(&createOptions.Secrets, secretFlagName, nil, "Add a secret to every container joined to the pod, unless a container specifies its own secret with the same target")
+	_ = createCommand.RegisterFlagCompletionFunc(secretFlagName, common.AutocompleteSecrets)
+
 	flags.SetNormalizeFunc(aliasNetworkFlag)
 }
 
@@ -259,10 +280,13 @@ func create(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
-		podSpec.Volumes = podSpec.InfraContainerSpec.Volumes
-		podSpec.ImageVolumes = podSpec.InfraContainerSpec.ImageVolumes
-		podSpec.OverlayVolumes = podSpec.InfraContainerSpec.OverlayVolumes
-		podSpec.Mounts = podSpec.InfraContainerSpec.Mounts
+		// Append rather than overwrite: podSpec.Volumes may already carry
+		// pod-scoped volumes parsed from --volume above, and those must
+		// survive this mirroring of the infra container's own spec.
+		podSpec.Volumes = append(podSpec.Volumes, podSpec.InfraContainerSpec.Volumes...)
+		podSpec.ImageVolumes = append(podSpec.ImageVolumes, podSpec.InfraContainerSpec.ImageVolumes...)
+		podSpec.OverlayVolumes = append(podSpec.OverlayVolumes, podSpec.InfraContainerSpec.OverlayVolumes...)
+		podSpec.Mounts = append(podSpec.Mounts, podSpec.InfraContainerSpec.Mounts...)
 
 		// Marshall and Unmarshal the spec in order to map similar entities
 		wrapped, err := json.Marshal(podSpec.InfraContainerSpec)
@@ -89,6 +89,7 @@ func logsFlags(cmd *cobra.Command) {
 
 	flags.BoolVarP(&logsPodOptions.Names, "names", "n", false, "Output container names instead of container IDs in the log")
 	flags.BoolVarP(&logsPodOptions.Timestamps, "timestamps", "t", false, "Output the timestamps in the log")
+	flags.BoolVar(&logsPodOptions.Colors, "color", false, "Output the containers with a different color")
 	flags.SetInterspersed(false)
 	_ = flags.MarkHidden("details")
 }
@@ -64,10 +64,15 @@ func init() {
 	flags.UintVarP(&stopTimeout, timeFlagName, "t", containerConfig.Engine.StopTimeout, "Seconds to wait for pod stop before killing the container")
 	_ = rmCommand.RegisterFlagCompletionFunc(timeFlagName, completion.AutocompleteNone)
 
+	flags.BoolVarP(&rmOptions.Volumes, "volumes", "v", false, "Remove the pod's volumes")
+
 	validate.AddLatestFlag(rmCommand, &rmOptions.Latest)
 
 	if registry.IsRemote() {
 		_ = flags.MarkHidden("ignore")
+		// Removing a pod's volumes is not yet supported over the remote
+		// API.
+		_ = flags.MarkHidden("volumes")
 	}
 }
 
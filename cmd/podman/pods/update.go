@@ -0,0 +1,69 @@
+package pods
+
+import (
+	"context"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/parse"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	podUpdateDescription = `Update the memory and PIDs limits, restart policy, and labels of an existing pod, without recreating it.`
+	updateCommand        = &cobra.Command{
+		Use:               "update [options] POD",
+		Short:             "Update the configuration of an existing pod",
+		Long:              podUpdateDescription,
+		RunE:              update,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompletePods,
+		Example: `podman pod update --memory 1g mypod
+  podman pod update --pids-limit 100 mypod
+  podman pod update --restart on-failure:5 mypod`,
+	}
+
+	updateOpts   = entities.PodUpdateOptions{}
+	updateLabels []string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: updateCommand,
+		Parent:  podCmd,
+	})
+	flags := updateCommand.Flags()
+
+	memoryFlagName := "memory"
+	flags.StringVarP(&updateOpts.Memory, memoryFlagName, "m", "", "Memory limit enforced on the pod's cgroup, bounding all containers in the pod")
+	_ = updateCommand.RegisterFlagCompletionFunc(memoryFlagName, completion.AutocompleteNone)
+
+	pidsLimitFlagName := "pids-limit"
+	flags.Int64Var(&updateOpts.PidsLimit, pidsLimitFlagName, 0, "Tune the pod's PIDs limit, bounding all containers in the pod")
+	_ = updateCommand.RegisterFlagCompletionFunc(pidsLimitFlagName, completion.AutocompleteNone)
+
+	restartFlagName := "restart"
+	flags.StringVar(&updateOpts.Restart, restartFlagName, "", `Restart policy applied to the pod's infra container, governing infra (and thus pod) recreation ("always"|"no"|"on-failure")`)
+	_ = updateCommand.RegisterFlagCompletionFunc(restartFlagName, common.AutocompleteRestartOption)
+
+	labelFlagName := "label"
+	flags.StringArrayVarP(&updateLabels, labelFlagName, "l", nil, "Add or overwrite a label on the pod")
+	_ = updateCommand.RegisterFlagCompletionFunc(labelFlagName, completion.AutocompleteNone)
+}
+
+func update(cmd *cobra.Command, args []string) error {
+	if updateOpts.Memory == "" && updateOpts.PidsLimit == 0 && updateOpts.Restart == "" && len(updateLabels) == 0 {
+		return errors.New("you must provide at least one option to update")
+	}
+
+	labels, err := parse.GetAllLabels(nil, updateLabels)
+	if err != nil {
+		return errors.Wrapf(err, "unable to process labels")
+	}
+	updateOpts.Labels = labels
+
+	return registry.ContainerEngine().PodUpdate(context.Background(), args[0], updateOpts)
+}
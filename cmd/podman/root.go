@@ -16,6 +16,7 @@ import (
 	"github.com/containers/podman/v4/cmd/podman/validate"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/checkpoint/crutils"
+	"github.com/containers/podman/v4/pkg/connection"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/containers/podman/v4/pkg/parallel"
 	"github.com/containers/podman/v4/pkg/rootless"
@@ -71,9 +72,11 @@ var (
 		DisableFlagsInUseLine: true,
 	}
 
-	logLevel       = "warn"
-	useSyslog      bool
-	requireCleanup = true
+	logLevel        = "warn"
+	useSyslog       bool
+	logSeccompAudit bool
+	eventsSnapshot  bool
+	requireCleanup  = true
 )
 
 func init() {
@@ -187,6 +190,14 @@ func persistentPreRunE(cmd *cobra.Command, args []string) error {
 		if err := cmd.Root().LocalFlags().Set("identity", cfg.Identity); err != nil {
 			return errors.Wrap(err, "failed to override --identity flag")
 		}
+
+		// A connection may carry its own default namespace; an explicit
+		// --namespace on the command line always wins.
+		if namespace, err := connection.Namespace(conn.Value.String()); err == nil && namespace != "" {
+			if ns := cmd.Root().PersistentFlags().Lookup("namespace"); ns != nil && !ns.Changed {
+				cfg.Engine.Namespace = namespace
+			}
+		}
 	}
 
 	// Special case if command is hidden completion command ("__complete","__completeNoDesc")
@@ -423,6 +434,8 @@ func rootFlags(cmd *cobra.Command, opts *entities.PodmanConfig) {
 		pFlags.StringVar(&opts.StorageDriver, storageDriverFlagName, "", "Select which storage driver is used to manage storage of images and containers")
 		_ = cmd.RegisterFlagCompletionFunc(storageDriverFlagName, completion.AutocompleteNone) //TODO: what can we recommend here?
 
+		pFlags.BoolVar(&opts.TransientStore, "transient-store", false, "Enable transient storage mode where container state and read-write layers live on tmpfs and do not persist across reboots (default false)")
+
 		tmpdirFlagName := "tmpdir"
 		pFlags.StringVar(&opts.Engine.TmpDir, tmpdirFlagName, "", "Path to the tmp directory for libpod state content.\n\nNote: use the environment variable 'TMPDIR' to change the temporary storage location for container images, '/var/tmp'.\n")
 		_ = cmd.RegisterFlagCompletionFunc(tmpdirFlagName, completion.AutocompleteDefault)
@@ -462,6 +475,10 @@ func rootFlags(cmd *cobra.Command, opts *entities.PodmanConfig) {
 		_ = rootCmd.RegisterFlagCompletionFunc(runtimeflagFlagName, completion.AutocompleteNone)
 
 		pFlags.BoolVar(&useSyslog, "syslog", false, "Output logging information to syslog as well as the console (default false)")
+
+		pFlags.BoolVar(&logSeccompAudit, "log-seccomp-audit", false, "Log container syscalls blocked or logged by seccomp as podman events (default false)")
+
+		pFlags.BoolVar(&eventsSnapshot, "events-snapshot", false, "Embed a compact object snapshot (config hash, image digest, exit code, health status) in container events (default false)")
 	}
 }
 
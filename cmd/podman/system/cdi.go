@@ -0,0 +1,23 @@
+package system
+
+import (
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	CDICmd = &cobra.Command{
+		Use:   "cdi",
+		Short: "Manage CDI devices",
+		Long:  `List and inspect the devices podman can resolve through the Container Device Interface (CDI)`,
+		RunE:  validate.SubCommandExists,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: CDICmd,
+		Parent:  systemCmd,
+	})
+}
@@ -0,0 +1,44 @@
+package cdi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/system"
+	"github.com/spf13/cobra"
+)
+
+var (
+	inspectCmd = &cobra.Command{
+		Use:               "inspect [options] NAME",
+		Short:             "Inspect a CDI device",
+		Long:              "Display the container edits a CDI device applies, such as device nodes, environment, hooks and mounts",
+		Args:              cobra.ExactArgs(1),
+		Example:           "podman system cdi inspect nvidia.com/gpu=0",
+		ValidArgsFunction: completion.AutocompleteNone,
+		RunE:              inspect,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: inspectCmd,
+		Parent:  system.CDICmd,
+	})
+}
+
+func inspect(cmd *cobra.Command, args []string) error {
+	report, err := registry.ContainerEngine().CDIInspect(context.Background(), args[0])
+	if err != nil {
+		return err
+	}
+
+	buf, err := registry.JSONLibrary().MarshalIndent(report, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(buf))
+	return nil
+}
@@ -0,0 +1,86 @@
+package cdi
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/system"
+	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	listCmd = &cobra.Command{
+		Use:     "list [options]",
+		Aliases: []string{"ls"},
+		Args:    validate.NoArgs,
+		Short:   "List CDI devices",
+		Long:    "List devices podman can resolve through the Container Device Interface (CDI)",
+		Example: `podman system cdi list
+  podman system cdi ls --format=json`,
+		ValidArgsFunction: completion.AutocompleteNone,
+		RunE:              list,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: listCmd,
+		Parent:  system.CDICmd,
+	})
+
+	listCmd.Flags().String("format", "", "Custom Go template for printing devices")
+	_ = listCmd.RegisterFlagCompletionFunc("format", common.AutocompleteFormat(entities.CDIDevice{}))
+}
+
+func list(cmd *cobra.Command, _ []string) error {
+	devices, err := registry.ContainerEngine().CDIList(context.Background())
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].QualifiedName < devices[j].QualifiedName
+	})
+
+	if report.IsJSON(cmd.Flag("format").Value.String()) {
+		buf, err := registry.JSONLibrary().MarshalIndent(devices, "", "    ")
+		if err == nil {
+			fmt.Println(string(buf))
+		}
+		return err
+	}
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	if cmd.Flag("format").Changed {
+		rpt, err = rpt.Parse(report.OriginUser, cmd.Flag("format").Value.String())
+	} else {
+		rpt, err = rpt.Parse(report.OriginPodman,
+			"{{range .}}{{.QualifiedName}}\t{{.Vendor}}\t{{.Class}}\t{{.SpecFile}}\n{{end -}}")
+	}
+	if err != nil {
+		return err
+	}
+
+	if rpt.RenderHeaders {
+		err = rpt.Execute([]map[string]string{{
+			"QualifiedName": "QUALIFIED NAME",
+			"Vendor":        "VENDOR",
+			"Class":         "CLASS",
+			"SpecFile":      "SPEC FILE",
+		}})
+		if err != nil {
+			return err
+		}
+	}
+	return rpt.Execute(devices)
+}
@@ -0,0 +1,96 @@
+//go:build !remote
+// +build !remote
+
+package system
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/containers/podman/v4/pkg/domain/infra"
+	"github.com/spf13/cobra"
+)
+
+var (
+	checkDescription = `
+        podman system check
+
+        Cross-check containers-storage, the libpod database, and named
+        volumes for consistency, and optionally repair what it can.
+`
+
+	checkCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "check [options]",
+		Args:              validate.NoArgs,
+		Short:             "Check storage and state consistency",
+		Long:              checkDescription,
+		Run:               check,
+		ValidArgsFunction: completion.AutocompleteNone,
+	}
+)
+
+var checkOptions entities.SystemCheckOptions
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: checkCommand,
+		Parent:  systemCmd,
+	})
+
+	flags := checkCommand.Flags()
+	flags.BoolVar(&checkOptions.Repair, "repair", false, "Repair or quarantine broken entries that can be safely fixed")
+}
+
+func check(cmd *cobra.Command, args []string) {
+	// Shutdown all running engines, this will hijack the repository like renumber and migrate do
+	registry.ContainerEngine().Shutdown(registry.Context())
+	registry.ImageEngine().Shutdown(registry.Context())
+
+	engine, err := infra.NewSystemEngine(entities.NormalMode, registry.PodmanConfig())
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(define.ExecErrorCodeGeneric)
+	}
+	defer engine.Shutdown(registry.Context())
+
+	report, err := engine.Check(registry.Context(), checkOptions)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(define.ExecErrorCodeGeneric)
+	}
+
+	if report.Empty() {
+		fmt.Println("No inconsistencies found.")
+		return
+	}
+
+	for _, id := range report.OrphanStorageContainers {
+		fmt.Printf("Orphan storage container (no libpod record): %s\n", id)
+	}
+	for _, id := range report.MissingStorageContainers {
+		fmt.Printf("Container missing backing storage: %s\n", id)
+	}
+	for _, name := range report.MissingVolumes {
+		fmt.Printf("Volume missing its mountpoint: %s\n", name)
+	}
+	for _, msg := range report.Repaired {
+		fmt.Printf("Repaired: %s\n", msg)
+	}
+	for _, msg := range report.RepairErrors {
+		fmt.Printf("Could not repair: %s\n", msg)
+	}
+
+	if !checkOptions.Repair {
+		fmt.Println("Run `podman system check --repair` to attempt to fix the issues above.")
+	}
+
+	if len(report.RepairErrors) > 0 || (!checkOptions.Repair && !report.Empty()) {
+		os.Exit(1)
+	}
+}
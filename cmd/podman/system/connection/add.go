@@ -15,6 +15,7 @@ import (
 	"github.com/containers/podman/v4/cmd/podman/registry"
 	"github.com/containers/podman/v4/cmd/podman/system"
 	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/pkg/connection"
 	"github.com/containers/podman/v4/pkg/terminal"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -41,14 +42,16 @@ var (
   podman system connection add --identity ~/.ssh/dev_rsa testing ssh://root@server.fubar.com:2222
   podman system connection add --identity ~/.ssh/dev_rsa --port 22 production root@server.fubar.com
   podman system connection add debug tcp://localhost:8080
+  podman system connection add --namespace staging staging ssh://root@server.fubar.com
   `,
 	}
 
 	cOpts = struct {
-		Identity string
-		Port     int
-		UDSPath  string
-		Default  bool
+		Identity  string
+		Port      int
+		UDSPath   string
+		Default   bool
+		Namespace string
 	}{}
 )
 
@@ -73,6 +76,10 @@ func init() {
 	_ = addCmd.RegisterFlagCompletionFunc(socketPathFlagName, completion.AutocompleteDefault)
 
 	flags.BoolVarP(&cOpts.Default, "default", "d", false, "Set connection to be default")
+
+	namespaceFlagName := "namespace"
+	flags.StringVar(&cOpts.Namespace, namespaceFlagName, "", "libpod namespace to use by default when connecting")
+	_ = addCmd.RegisterFlagCompletionFunc(namespaceFlagName, completion.AutocompleteNone)
 }
 
 func add(cmd *cobra.Command, args []string) error {
@@ -169,6 +176,12 @@ func add(cmd *cobra.Command, args []string) error {
 		dst.Identity = cOpts.Identity
 	}
 
+	if cmd.Flags().Changed("namespace") {
+		if err := connection.SetNamespace(args[0], cOpts.Namespace); err != nil {
+			return err
+		}
+	}
+
 	if cfg.Engine.ServiceDestinations == nil {
 		cfg.Engine.ServiceDestinations = map[string]config.Destination{
 			args[0]: dst,
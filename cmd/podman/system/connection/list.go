@@ -12,6 +12,7 @@ import (
 	"github.com/containers/podman/v4/cmd/podman/registry"
 	"github.com/containers/podman/v4/cmd/podman/system"
 	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/containers/podman/v4/pkg/connection"
 	"github.com/spf13/cobra"
 )
 
@@ -44,7 +45,8 @@ func init() {
 type namedDestination struct {
 	Name string
 	config.Destination
-	Default bool
+	Namespace string
+	Default   bool
 }
 
 func list(cmd *cobra.Command, _ []string) error {
@@ -60,13 +62,19 @@ func list(cmd *cobra.Command, _ []string) error {
 			def = true
 		}
 
+		namespace, err := connection.Namespace(k)
+		if err != nil {
+			return err
+		}
+
 		r := namedDestination{
 			Name: k,
 			Destination: config.Destination{
 				Identity: v.Identity,
 				URI:      v.URI,
 			},
-			Default: def,
+			Namespace: namespace,
+			Default:   def,
 		}
 		rows = append(rows, r)
 	}
@@ -90,7 +98,7 @@ func list(cmd *cobra.Command, _ []string) error {
 		rpt, err = rpt.Parse(report.OriginUser, cmd.Flag("format").Value.String())
 	} else {
 		rpt, err = rpt.Parse(report.OriginPodman,
-			"{{range .}}{{.Name}}\t{{.URI}}\t{{.Identity}}\t{{.Default}}\n{{end -}}")
+			"{{range .}}{{.Name}}\t{{.URI}}\t{{.Identity}}\t{{.Namespace}}\t{{.Default}}\n{{end -}}")
 	}
 	if err != nil {
 		return err
@@ -98,10 +106,11 @@ func list(cmd *cobra.Command, _ []string) error {
 
 	if rpt.RenderHeaders {
 		err = rpt.Execute([]map[string]string{{
-			"Default":  "Default",
-			"Identity": "Identity",
-			"Name":     "Name",
-			"URI":      "URI",
+			"Default":   "Default",
+			"Identity":  "Identity",
+			"Name":      "Name",
+			"URI":       "URI",
+			"Namespace": "Namespace",
 		}})
 		if err != nil {
 			return err
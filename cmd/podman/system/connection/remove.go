@@ -5,6 +5,7 @@ import (
 	"github.com/containers/podman/v4/cmd/podman/common"
 	"github.com/containers/podman/v4/cmd/podman/registry"
 	"github.com/containers/podman/v4/cmd/podman/system"
+	"github.com/containers/podman/v4/pkg/connection"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
@@ -47,6 +48,9 @@ func rm(cmd *cobra.Command, args []string) error {
 		if cfg.Engine.ServiceDestinations != nil {
 			for k := range cfg.Engine.ServiceDestinations {
 				delete(cfg.Engine.ServiceDestinations, k)
+				if err := connection.RemoveNamespace(k); err != nil {
+					return err
+				}
 			}
 		}
 		cfg.Engine.ActiveService = ""
@@ -60,6 +64,9 @@ func rm(cmd *cobra.Command, args []string) error {
 	if cfg.Engine.ServiceDestinations != nil {
 		delete(cfg.Engine.ServiceDestinations, args[0])
 	}
+	if err := connection.RemoveNamespace(args[0]); err != nil {
+		return err
+	}
 
 	if cfg.Engine.ActiveService == args[0] {
 		cfg.Engine.ActiveService = ""
@@ -7,6 +7,7 @@ import (
 	"github.com/containers/podman/v4/cmd/podman/common"
 	"github.com/containers/podman/v4/cmd/podman/registry"
 	"github.com/containers/podman/v4/cmd/podman/system"
+	"github.com/containers/podman/v4/pkg/connection"
 	"github.com/spf13/cobra"
 )
 
@@ -45,6 +46,17 @@ func rename(cmd *cobra.Command, args []string) error {
 	cfg.Engine.ServiceDestinations[args[1]] = cfg.Engine.ServiceDestinations[args[0]]
 	delete(cfg.Engine.ServiceDestinations, args[0])
 
+	namespace, err := connection.Namespace(args[0])
+	if err != nil {
+		return err
+	}
+	if err := connection.SetNamespace(args[1], namespace); err != nil {
+		return err
+	}
+	if err := connection.RemoveNamespace(args[0]); err != nil {
+		return err
+	}
+
 	if cfg.Engine.ActiveService == args[0] {
 		cfg.Engine.ActiveService = args[1]
 	}
@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/common"
 	"github.com/containers/podman/v4/cmd/podman/registry"
 	"github.com/containers/podman/v4/cmd/podman/validate"
 	"github.com/containers/podman/v4/libpod/define"
@@ -52,6 +53,10 @@ func init() {
 	newRuntimeFlagName := "new-runtime"
 	flags.StringVar(&migrateOptions.NewRuntime, newRuntimeFlagName, "", "Specify a new runtime for all containers")
 	_ = migrateCommand.RegisterFlagCompletionFunc(newRuntimeFlagName, completion.AutocompleteNone)
+
+	newDBFlagName := "new-db"
+	flags.StringVar(&migrateOptions.NewDB, newDBFlagName, "", "Convert the state database to the given backend (boltdb, sqlite)")
+	_ = migrateCommand.RegisterFlagCompletionFunc(newDBFlagName, common.AutocompleteStateStores)
 }
 
 func migrate(cmd *cobra.Command, args []string) {
@@ -41,9 +41,18 @@ Enable a listening service for API access to Podman commands.
 	}
 
 	srvArgs = struct {
-		CorsHeaders string
-		PProfAddr   string
-		Timeout     uint
+		CorsHeaders              string
+		PProfAddr                string
+		Timeout                  uint
+		TLSCert                  string
+		TLSKey                   string
+		TLSCACert                string
+		AuthzPlugin              string
+		AuthzPolicyFile          string
+		RateLimit                uint
+		AuditLogPath             string
+		IdempotencyWindow        uint
+		StopContainersOnShutdown bool
 	}{}
 )
 
@@ -68,6 +77,49 @@ func init() {
 	flags.StringVarP(&srvArgs.PProfAddr, "pprof-address", "", "",
 		"Binding network address for pprof profile endpoints, default: do not expose endpoints")
 	_ = flags.MarkHidden("pprof-address")
+
+	tlsCertFlagName := "tls-cert"
+	flags.StringVar(&srvArgs.TLSCert, tlsCertFlagName, "", "Path to TLS certificate for a tcp:// URI, enables TLS")
+	_ = srvCmd.RegisterFlagCompletionFunc(tlsCertFlagName, completion.AutocompleteDefault)
+
+	tlsKeyFlagName := "tls-key"
+	flags.StringVar(&srvArgs.TLSKey, tlsKeyFlagName, "", "Path to TLS private key matching --tls-cert")
+	_ = srvCmd.RegisterFlagCompletionFunc(tlsKeyFlagName, completion.AutocompleteDefault)
+
+	tlsCACertFlagName := "tls-ca-cert"
+	flags.StringVar(&srvArgs.TLSCACert, tlsCACertFlagName, "", "Path to CA certificate used to require and verify client certificates")
+	_ = srvCmd.RegisterFlagCompletionFunc(tlsCACertFlagName, completion.AutocompleteDefault)
+
+	authorizationPluginFlagName := "authorization-plugin"
+	flags.StringVar(&srvArgs.AuthzPlugin, authorizationPluginFlagName, "", "Unix socket of an authorization plugin consulted to allow or deny each API request")
+	_ = srvCmd.RegisterFlagCompletionFunc(authorizationPluginFlagName, completion.AutocompleteDefault)
+
+	authorizationPolicyFlagName := "authorization-policy"
+	flags.StringVar(&srvArgs.AuthzPolicyFile, authorizationPolicyFlagName, "", "Path to a local authorization policy file consulted to allow or deny each API request")
+	_ = srvCmd.RegisterFlagCompletionFunc(authorizationPolicyFlagName, completion.AutocompleteDefault)
+
+	// RateLimit, AuditLogPath and IdempotencyWindow have no containers.conf
+	// default: EngineConfig is defined in the vendored containers/common
+	// module, and podman cannot add fields to a dependency's types without
+	// a real upstream vendor bump. --time above is the model to follow once
+	// such a bump adds the matching containers.conf keys.
+	rateLimitFlagName := "rate-limit"
+	flags.UintVar(&srvArgs.RateLimit, rateLimitFlagName, 0,
+		"Maximum requests per second accepted from a single client. Use 0 to disable rate limiting")
+	_ = srvCmd.RegisterFlagCompletionFunc(rateLimitFlagName, completion.AutocompleteNone)
+
+	auditLogFlagName := "audit-log-path"
+	flags.StringVar(&srvArgs.AuditLogPath, auditLogFlagName, "",
+		"Path of a structured audit log to write an entry to for every request")
+	_ = srvCmd.RegisterFlagCompletionFunc(auditLogFlagName, completion.AutocompleteDefault)
+
+	idempotencyWindowFlagName := "idempotency-window"
+	flags.UintVar(&srvArgs.IdempotencyWindow, idempotencyWindowFlagName, 0,
+		"Number of seconds an Idempotency-Key header value is remembered for. Use 0 to disable")
+	_ = srvCmd.RegisterFlagCompletionFunc(idempotencyWindowFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&srvArgs.StopContainersOnShutdown, "shutdown-stop-containers", false,
+		"Stop running containers, in reverse dependency order, when the service shuts down")
 }
 
 func aliasTimeoutFlag(_ *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -101,10 +153,19 @@ func service(cmd *cobra.Command, args []string) error {
 	}
 
 	return restService(cmd.Flags(), registry.PodmanConfig(), entities.ServiceOptions{
-		CorsHeaders: srvArgs.CorsHeaders,
-		PProfAddr:   srvArgs.PProfAddr,
-		Timeout:     time.Duration(srvArgs.Timeout) * time.Second,
-		URI:         apiURI,
+		CorsHeaders:              srvArgs.CorsHeaders,
+		PProfAddr:                srvArgs.PProfAddr,
+		Timeout:                  time.Duration(srvArgs.Timeout) * time.Second,
+		URI:                      apiURI,
+		TLSCert:                  srvArgs.TLSCert,
+		TLSKey:                   srvArgs.TLSKey,
+		TLSCACert:                srvArgs.TLSCACert,
+		AuthzPlugin:              srvArgs.AuthzPlugin,
+		AuthzPolicyFile:          srvArgs.AuthzPolicyFile,
+		RateLimit:                srvArgs.RateLimit,
+		AuditLogPath:             srvArgs.AuditLogPath,
+		IdempotencyWindow:        time.Duration(srvArgs.IdempotencyWindow) * time.Second,
+		StopContainersOnShutdown: srvArgs.StopContainersOnShutdown,
 	})
 }
 
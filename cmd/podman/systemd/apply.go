@@ -0,0 +1,89 @@
+package systemd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/containers/podman/v4/pkg/quadlet"
+	"github.com/containers/podman/v4/pkg/specgen"
+	"github.com/containers/podman/v4/pkg/specgen/generate"
+	"github.com/containers/podman/v4/pkg/specgenutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	applyDescription = `Read *.container unit files from a directory and create (or replace)
+the podman containers they declare, so a host can be managed as config
+files rather than one-off "podman run" invocations.
+
+Only the [Container] section of each unit is understood; [Unit],
+[Service] and [Install] sections, and .volume/.network/.kube units, are
+not yet supported.`
+
+	applyCommand = &cobra.Command{
+		Use:               "apply [options] DIRECTORY",
+		Short:             "Create containers declared by *.container unit files in DIRECTORY",
+		Long:              applyDescription,
+		Args:              cobra.ExactArgs(1),
+		RunE:              apply,
+		ValidArgsFunction: completion.AutocompleteDefault,
+		Example:           `podman systemd apply /etc/containers/systemd`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: applyCommand,
+		Parent:  systemdCmd,
+	})
+}
+
+func apply(cmd *cobra.Command, args []string) error {
+	units, err := quadlet.LoadContainerUnitsFromDir(args[0])
+	if err != nil {
+		return errors.Wrapf(err, "reading unit files from %q", args[0])
+	}
+
+	for _, unit := range units {
+		name := unit.ContainerName
+		if name == "" {
+			name = unit.UnitName()
+		}
+
+		createOptions := entities.ContainerCreateOptions{
+			Name:   name,
+			Env:    unit.Environment,
+			Volume: unit.Volume,
+		}
+
+		s := specgen.NewSpecGenerator(unit.Image, false)
+		cliArgs := append([]string{unit.Image}, unit.Exec...)
+		if err := specgenutil.FillOutSpecGen(s, &createOptions, cliArgs); err != nil {
+			return errors.Wrapf(err, "building container spec for unit %q", unit.UnitName())
+		}
+
+		if len(unit.PublishPort) > 0 {
+			pubPorts, err := specgenutil.CreatePortBindings(unit.PublishPort)
+			if err != nil {
+				return errors.Wrapf(err, "parsing PublishPort for unit %q", unit.UnitName())
+			}
+			ports, err := generate.ParsePortMapping(pubPorts, nil)
+			if err != nil {
+				return errors.Wrapf(err, "parsing PublishPort for unit %q", unit.UnitName())
+			}
+			s.PortMappings = ports
+		}
+
+		report, err := registry.ContainerEngine().ContainerCreate(context.Background(), s)
+		if err != nil {
+			return errors.Wrapf(err, "creating container for unit %q", unit.UnitName())
+		}
+		fmt.Println(report.Id)
+	}
+
+	return nil
+}
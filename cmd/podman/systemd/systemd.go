@@ -0,0 +1,23 @@
+package systemd
+
+import (
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command: podman _systemd_
+	systemdCmd = &cobra.Command{
+		Use:   "systemd",
+		Short: "Manage podman containers declared as systemd unit files",
+		Long:  "Manage podman containers declared as systemd unit files",
+		RunE:  validate.SubCommandExists,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: systemdCmd,
+	})
+}
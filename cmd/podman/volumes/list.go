@@ -61,6 +61,14 @@ func init() {
 
 	flags.Bool("noheading", false, "Do not print headers")
 	flags.BoolVarP(&cliOpts.Quiet, "quiet", "q", false, "Print volume output in quiet mode")
+
+	limitFlagName := "limit"
+	flags.IntVar(&lsOpts.Limit, limitFlagName, 0, "Limit the number of volumes returned, newest first. Use 0 for no limit")
+	_ = lsCommand.RegisterFlagCompletionFunc(limitFlagName, completion.AutocompleteNone)
+
+	offsetFlagName := "offset"
+	flags.IntVar(&lsOpts.Offset, offsetFlagName, 0, "Skip the first n newest volumes before applying --limit")
+	_ = lsCommand.RegisterFlagCompletionFunc(offsetFlagName, completion.AutocompleteNone)
 }
 
 func list(cmd *cobra.Command, args []string) error {
@@ -63,6 +63,11 @@ type BoltState struct {
 //   initially created the database. This must match for any further instances
 //   that access the database, to ensure that state mismatches with
 //   containers/storage do not occur.
+// - ctrSummaryBkt: Map of ID to a JSON-encoded ContainerSummary, a small
+//   denormalized copy of the fields "podman ps" needs most (name, image,
+//   state, ports, labels). Kept up to date as containers are created,
+//   removed, and change state, so listing containers does not require
+//   opening every container's sub-bucket in ctrBkt.
 
 // NewBoltState creates a new bolt-backed state database
 func NewBoltState(path string, runtime *Runtime) (State, error) {
@@ -98,6 +103,7 @@ func NewBoltState(path string, runtime *Runtime) (State, error) {
 		allVolsBkt,
 		execBkt,
 		runtimeConfigBkt,
+		ctrSummaryBkt,
 	}
 
 	// Does the DB need an update?
@@ -844,6 +850,10 @@ func (s *BoltState) SaveContainer(ctr *Container) error {
 			}
 		}
 
+		if err := putContainerSummary(tx, ctr); err != nil {
+			return err
+		}
+
 		return nil
 	})
 	return err
@@ -973,6 +983,70 @@ func (s *BoltState) AllContainers() ([]*Container, error) {
 	return ctrs, nil
 }
 
+// AllContainerSummaries returns a ContainerSummary for every container in
+// the DB namespace, read from the denormalized summary bucket in a single
+// scan rather than opening each container's own sub-bucket.
+//
+// If force is true, the summary bucket is ignored and the summaries are
+// rebuilt from each container's authoritative config and state instead,
+// which is slower but self-heals a summary bucket that has fallen out of
+// sync (for example, after a crash between writing container state and
+// updating its summary).
+func (s *BoltState) AllContainerSummaries(force bool) ([]define.ContainerSummary, error) {
+	if !s.valid {
+		return nil, define.ErrDBClosed
+	}
+
+	if force {
+		ctrs, err := s.AllContainers()
+		if err != nil {
+			return nil, err
+		}
+
+		summaries := make([]define.ContainerSummary, 0, len(ctrs))
+		for _, ctr := range ctrs {
+			summaries = append(summaries, define.ContainerSummary{
+				ID:     ctr.config.ID,
+				Name:   ctr.config.Name,
+				Image:  ctr.config.RootfsImageName,
+				State:  ctr.state.State.String(),
+				Ports:  ctr.config.PortMappings,
+				Labels: ctr.config.Labels,
+			})
+		}
+		return summaries, nil
+	}
+
+	summaries := []define.ContainerSummary{}
+
+	db, err := s.getDBCon()
+	if err != nil {
+		return nil, err
+	}
+	defer s.deferredCloseDBCon(db)
+
+	err = db.View(func(tx *bolt.Tx) error {
+		ctrSummaryBucket, err := getCtrSummaryBucket(tx)
+		if err != nil {
+			return err
+		}
+
+		return ctrSummaryBucket.ForEach(func(id, summaryBytes []byte) error {
+			summary := define.ContainerSummary{}
+			if err := json.Unmarshal(summaryBytes, &summary); err != nil {
+				return errors.Wrapf(err, "error unmarshalling container %s summary", string(id))
+			}
+			summaries = append(summaries, summary)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
 // GetNetworks returns the CNI networks this container is a part of.
 func (s *BoltState) GetNetworks(ctr *Container) (map[string]types.PerNetworkOptions, error) {
 	if !s.valid {
@@ -1208,6 +1282,67 @@ func (s *BoltState) NetworkConnect(ctr *Container, network string, opts types.Pe
 	})
 }
 
+// NetworkModify will allow you to set new options on an existing connected network
+func (s *BoltState) NetworkModify(ctr *Container, network string, opts types.PerNetworkOptions) error {
+	if !s.valid {
+		return define.ErrDBClosed
+	}
+
+	if !ctr.valid {
+		return define.ErrCtrRemoved
+	}
+
+	if network == "" {
+		return errors.Wrapf(define.ErrInvalidArg, "network names must not be empty")
+	}
+
+	if s.namespace != "" && s.namespace != ctr.config.Namespace {
+		return errors.Wrapf(define.ErrNSMismatch, "container %s is in namespace %q, does not match our namespace %q", ctr.ID(), ctr.config.Namespace, s.namespace)
+	}
+
+	optBytes, err := json.Marshal(opts)
+	if err != nil {
+		return errors.Wrapf(err, "error marshalling network options JSON for container %s", ctr.ID())
+	}
+
+	ctrID := []byte(ctr.ID())
+
+	db, err := s.getDBCon()
+	if err != nil {
+		return err
+	}
+	defer s.deferredCloseDBCon(db)
+
+	return db.Update(func(tx *bolt.Tx) error {
+		ctrBucket, err := getCtrBucket(tx)
+		if err != nil {
+			return err
+		}
+
+		dbCtr := ctrBucket.Bucket(ctrID)
+		if dbCtr == nil {
+			ctr.valid = false
+			return errors.Wrapf(define.ErrNoSuchCtr, "container %s does not exist in database", ctr.ID())
+		}
+
+		ctrNetworksBkt := dbCtr.Bucket(networksBkt)
+		if ctrNetworksBkt == nil {
+			return errors.Wrapf(define.ErrNoSuchNetwork, "container %s does not have a network bucket", ctr.ID())
+		}
+		netConnected := ctrNetworksBkt.Get([]byte(network))
+		if netConnected == nil {
+			return errors.Wrapf(define.ErrNoSuchNetwork, "container %s is not connected to network %q", ctr.ID(), network)
+		}
+
+		// Modify the network entry
+		if err := ctrNetworksBkt.Put([]byte(network), optBytes); err != nil {
+			return errors.Wrapf(err, "error modifying network %s of container %s in DB", network, ctr.ID())
+		}
+
+		return nil
+	})
+}
+
 // NetworkDisconnect disconnects the container from the given network, also
 // removing any aliases in the network.
 func (s *BoltState) NetworkDisconnect(ctr *Container, network string) error {
@@ -3044,6 +3179,11 @@ func (s *BoltState) RemovePodContainers(pod *Pod) error {
 			return errors.Wrapf(define.ErrInternal, "pod %s does not have a containers bucket", pod.ID())
 		}
 
+		ctrSummaryBucket, err := getCtrSummaryBucket(tx)
+		if err != nil {
+			return err
+		}
+
 		// Traverse all containers in the pod with a cursor
 		// for-each has issues with data mutation
 		err = podCtrsBkt.ForEach(func(id, name []byte) error {
@@ -3088,6 +3228,10 @@ func (s *BoltState) RemovePodContainers(pod *Pod) error {
 				return errors.Wrapf(err, "error deleting container %s ID from all containers bucket in DB", string(id))
 			}
 
+			if err := ctrSummaryBucket.Delete(id); err != nil {
+				return errors.Wrapf(err, "error deleting container %s summary from DB", string(id))
+			}
+
 			return nil
 		})
 		if err != nil {
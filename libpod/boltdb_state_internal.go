@@ -28,6 +28,7 @@ const (
 	execName          = "exec"
 	aliasesName       = "aliases"
 	runtimeConfigName = "runtime-config"
+	ctrSummaryName    = "ctr-summary"
 
 	configName         = "config"
 	stateName          = "state"
@@ -61,6 +62,7 @@ var (
 	execBkt            = []byte(execName)
 	aliasesBkt         = []byte(aliasesName)
 	runtimeConfigBkt   = []byte(runtimeConfigName)
+	ctrSummaryBkt      = []byte(ctrSummaryName)
 	dependenciesBkt    = []byte(dependenciesName)
 	volDependenciesBkt = []byte(volCtrDependencies)
 	networksBkt        = []byte(networksName)
@@ -314,6 +316,49 @@ func getAllCtrsBucket(tx *bolt.Tx) (*bolt.Bucket, error) {
 	return bkt, nil
 }
 
+func getCtrSummaryBucket(tx *bolt.Tx) (*bolt.Bucket, error) {
+	bkt := tx.Bucket(ctrSummaryBkt)
+	if bkt == nil {
+		return nil, errors.Wrapf(define.ErrDBBadConfig, "container summary bucket not found in DB")
+	}
+	return bkt, nil
+}
+
+// putContainerSummary builds a ContainerSummary from the container's current
+// config and state and (re)writes it to the summary bucket, overwriting any
+// previous entry for the container.
+func putContainerSummary(tx *bolt.Tx, ctr *Container) error {
+	bkt, err := getCtrSummaryBucket(tx)
+	if err != nil {
+		return err
+	}
+
+	imageID, imageName := ctr.config.RootfsImageID, ctr.config.RootfsImageName
+	if imageName == "" {
+		imageName = imageID
+	}
+
+	summary := define.ContainerSummary{
+		ID:     ctr.config.ID,
+		Name:   ctr.config.Name,
+		Image:  imageName,
+		State:  ctr.state.State.String(),
+		Ports:  ctr.config.PortMappings,
+		Labels: ctr.config.Labels,
+	}
+
+	summaryJSON, err := json.Marshal(summary)
+	if err != nil {
+		return errors.Wrapf(err, "error marshalling container %s summary to JSON", ctr.ID())
+	}
+
+	if err := bkt.Put([]byte(ctr.config.ID), summaryJSON); err != nil {
+		return errors.Wrapf(err, "error adding container %s summary to DB", ctr.ID())
+	}
+
+	return nil
+}
+
 func getPodBucket(tx *bolt.Tx) (*bolt.Bucket, error) {
 	bkt := tx.Bucket(podBkt)
 	if bkt == nil {
@@ -790,6 +835,10 @@ func (s *BoltState) addContainer(ctr *Container, pod *Pod) error {
 			}
 		}
 
+		if err := putContainerSummary(tx, ctr); err != nil {
+			return err
+		}
+
 		return nil
 	})
 	return err
@@ -987,6 +1036,14 @@ func (s *BoltState) removeContainer(ctr *Container, pod *Pod, tx *bolt.Tx) error
 		}
 	}
 
+	ctrSummaryBucket, err := getCtrSummaryBucket(tx)
+	if err != nil {
+		return err
+	}
+	if err := ctrSummaryBucket.Delete(ctrID); err != nil {
+		return errors.Wrapf(err, "error deleting container %s summary from DB", ctr.ID())
+	}
+
 	return nil
 }
 
@@ -129,6 +129,11 @@ type Container struct {
 	// mounts etc.
 	notifySocket string
 
+	// notifyProxyConn is the listener podman uses, when set, to proxy
+	// sd-notify messages sent by the container's process on to
+	// notifySocket. Only used when SdNotifyMode is "container".
+	notifyProxyConn *net.UnixConn
+
 	slirp4netnsSubnet *net.IPNet
 }
 
@@ -165,6 +170,17 @@ type ContainerState struct {
 	PID int `json:"pid,omitempty"`
 	// ConmonPID is the PID of the container's conmon
 	ConmonPID int `json:"conmonPid,omitempty"`
+	// CgroupPath is the cgroups "path" of the container, captured while it
+	// is running so that it remains available for a final resource
+	// accounting pass after the container's process has exited but before
+	// its cgroup has been removed.
+	CgroupPath string `json:"cgroupPath,omitempty"`
+	// ResourceAccounting holds a snapshot of the container's cumulative
+	// resource usage captured at exit, if it created a cgroup.
+	ResourceAccounting *define.ContainerResourceAccounting `json:"resourceAccounting,omitempty"`
+	// OOMStatus holds the container's OOM-kill and memory pressure
+	// counters, captured at exit, if it created a cgroup.
+	OOMStatus *define.ContainerOOMStatus `json:"oomStatus,omitempty"`
 	// ExecSessions contains all exec sessions that are associated with this
 	// container.
 	ExecSessions map[string]*ExecSession `json:"newExecSessions,omitempty"`
@@ -739,6 +755,20 @@ func (c *Container) OOMKilled() (bool, error) {
 	return c.state.OOMKilled, nil
 }
 
+// ResourceAccounting returns a snapshot of the container's cumulative
+// resource usage captured at its last exit, or nil if the container has
+// never exited or created no cgroup.
+func (c *Container) ResourceAccounting() (*define.ContainerResourceAccounting, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if err := c.syncContainer(); err != nil {
+			return nil, errors.Wrapf(err, "error updating container %s state", c.ID())
+		}
+	}
+	return c.state.ResourceAccounting, nil
+}
+
 // PID returns the PID of the container.
 // If the container is not running, a pid of 0 will be returned. No error will
 // occur.
@@ -936,6 +966,16 @@ func (c *Container) cGroupPath() (string, error) {
 		return "", errors.Wrapf(define.ErrCtrStopped, "cannot get cgroup path unless container %s is running", c.ID())
 	}
 
+	return c.resolveCgroupPathForPID(c.state.PID)
+}
+
+// resolveCgroupPathForPID returns the cgroups "path" of the process with the
+// given PID, using the same heuristics as cGroupPath. It does not require
+// the container to be in any particular state, so it can also be used to
+// capture a container's cgroup path while it is still known to be valid
+// (e.g. right after the container's PID is obtained at start), for later
+// use once the container has stopped and its PID is no longer running.
+func (c *Container) resolveCgroupPathForPID(pid int) (string, error) {
 	// Read /proc/{PID}/cgroup and find the *longest* cgroup entry.  That's
 	// needed to account for hacks in cgroups v1, where each line in the
 	// file could potentially point to a cgroup.  The longest one, however,
@@ -948,7 +988,7 @@ func (c *Container) cGroupPath() (string, error) {
 	// the named systemd cgroup hierarchy.  Ignore any named cgroups during
 	// the lookup.
 	// See #10602 for more details.
-	procPath := fmt.Sprintf("/proc/%d/cgroup", c.state.PID)
+	procPath := fmt.Sprintf("/proc/%d/cgroup", pid)
 	lines, err := ioutil.ReadFile(procPath)
 	if err != nil {
 		// If the file doesn't exist, it means the container could have been terminated
@@ -1164,7 +1204,7 @@ func (c *Container) Umask() string {
 	return c.config.Umask
 }
 
-//Secrets return the secrets in the container
+// Secrets return the secrets in the container
 func (c *Container) Secrets() []*ContainerSecret {
 	return c.config.Secrets
 }
@@ -1202,6 +1242,30 @@ func (c *Container) Networks() ([]string, error) {
 	return names, nil
 }
 
+// NetworkAliases returns the currently configured network-scoped aliases for
+// the given network the container is connected to.
+func (c *Container) NetworkAliases(name string) ([]string, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return nil, err
+		}
+	}
+
+	networks, err := c.networks()
+	if err != nil {
+		return nil, err
+	}
+
+	opts, ok := networks[name]
+	if !ok {
+		return nil, errors.Wrapf(define.ErrNoSuchNetwork, "container %s is not connected to network %s", c.ID(), name)
+	}
+	return opts.Aliases, nil
+}
+
 // NetworkMode gets the configured network mode for the container.
 // Get actual value from the database
 func (c *Container) NetworkMode() string {
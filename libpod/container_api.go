@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/containers/podman/v4/libpod/events"
 	"github.com/containers/podman/v4/pkg/signal"
 	"github.com/containers/storage/pkg/archive"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -88,6 +90,15 @@ func (c *Container) Start(ctx context.Context, recursive bool) error {
 			return err
 		}
 	}
+	// If the container was checkpointed on its last stop, restore it from
+	// that checkpoint instead of starting it cold.
+	if c.config.CheckpointOnStop && c.ensureState(define.ContainerStateExited) {
+		if _, err := os.Stat(filepath.Join(c.CheckpointPath(), "inventory.img")); err == nil {
+			_, _, err := c.restore(ctx, ContainerCheckpointOptions{})
+			return err
+		}
+	}
+
 	if err := c.prepareToStart(ctx, recursive); err != nil {
 		return err
 	}
@@ -188,6 +199,13 @@ func (c *Container) StopWithTimeout(timeout uint) error {
 		return errors.Wrapf(define.ErrCtrStateInvalid, "can only stop created or running containers. %s is in state %s", c.ID(), c.state.State.String())
 	}
 
+	if c.config.CheckpointOnStop && c.state.State == define.ContainerStateRunning {
+		if _, _, err := c.checkpoint(context.Background(), ContainerCheckpointOptions{}); err != nil {
+			return errors.Wrap(err, "checkpointing container on stop")
+		}
+		return nil
+	}
+
 	return c.stop(timeout)
 }
 
@@ -422,6 +440,79 @@ func (c *Container) Unpause() error {
 	return c.unpause()
 }
 
+// Update updates a running container's cgroup resource limits in place,
+// without requiring a restart. Device cgroup rules in resources.Devices are
+// appended to the container's existing rule set; resources.BlockIO, if set,
+// replaces the container's current block I/O limits outright.
+func (c *Container) Update(resources *spec.LinuxResources) error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	if c.state.State != define.ContainerStateRunning {
+		return errors.Wrapf(define.ErrCtrStateInvalid, "%q is not running, cannot update container resource limits", c.state.State)
+	}
+
+	ctrSpec, err := c.specFromState()
+	if err != nil {
+		return err
+	}
+	if ctrSpec.Linux == nil {
+		ctrSpec.Linux = new(spec.Linux)
+	}
+	if ctrSpec.Linux.Resources == nil {
+		ctrSpec.Linux.Resources = new(spec.LinuxResources)
+	}
+	ctrSpec.Linux.Resources.Devices = append(ctrSpec.Linux.Resources.Devices, resources.Devices...)
+	if resources.BlockIO != nil {
+		ctrSpec.Linux.Resources.BlockIO = resources.BlockIO
+	}
+
+	if err := c.ociRuntime.UpdateContainer(c, &spec.LinuxResources{Devices: ctrSpec.Linux.Resources.Devices, BlockIO: ctrSpec.Linux.Resources.BlockIO}); err != nil {
+		return err
+	}
+
+	if err := c.saveSpec(ctrSpec); err != nil {
+		return err
+	}
+
+	defer c.newContainerEvent(events.Update)
+	return c.save()
+}
+
+// UpdateRestartPolicy changes the container's restart policy and, if given,
+// its restart retry count. Does not affect the container's current run, only
+// what happens the next time it exits. Does not require the container to be
+// running.
+func (c *Container) UpdateRestartPolicy(policy string, retries *uint) error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	switch policy {
+	case define.RestartPolicyNone, define.RestartPolicyNo, define.RestartPolicyOnFailure, define.RestartPolicyAlways, define.RestartPolicyUnlessStopped:
+	default:
+		return errors.Wrapf(define.ErrInvalidArg, "%q is not a valid restart policy", policy)
+	}
+
+	c.config.RestartPolicy = policy
+	if retries != nil {
+		c.config.RestartRetries = *retries
+	}
+
+	return c.save()
+}
+
 // Export exports a container's root filesystem as a tar archive
 // The archive will be saved as a file at the given path
 func (c *Container) Export(path string) error {
@@ -354,6 +354,15 @@ type ContainerMiscConfig struct {
 	LogSize int64 `json:"logSize"`
 	// LogDriver driver for logs
 	LogDriver string `json:"logDriver"`
+	// LogForwardDriver is the remote log driver ("syslog", "fluentd" or
+	// "gelf") that container output is additionally shipped to. Requires
+	// LogDriver to be one of the local file-backed drivers, since it works
+	// by tailing that file. Empty means logs are only kept locally.
+	LogForwardDriver string `json:"logForwardDriver,omitempty"`
+	// LogForwardAddress is the network address of the LogForwardDriver
+	// endpoint, e.g. "tcp://logs.example.com:514". If no scheme is given,
+	// a driver-specific default network is used.
+	LogForwardAddress string `json:"logForwardAddress,omitempty"`
 	// File containing the conmon PID
 	ConmonPidFile string `json:"conmonPidFile,omitempty"`
 	// RestartPolicy indicates what action the container will take upon
@@ -367,6 +376,11 @@ type ContainerMiscConfig struct {
 	// restart the container. Used only if RestartPolicy is set to
 	// "on-failure".
 	RestartRetries uint `json:"restart_retries,omitempty"`
+	// CheckpointOnStop indicates that the container should be checkpointed
+	// instead of killed when it is stopped, and restored from that
+	// checkpoint the next time it is started rather than started cold.
+	// Requires CRIU and an OCI runtime that supports checkpoint/restore.
+	CheckpointOnStop bool `json:"checkpointOnStop,omitempty"`
 	// TODO log options for log drivers
 	// PostConfigureNetNS needed when a user namespace is created by an OCI runtime
 	// if the network namespace is created before the user namespace it will be
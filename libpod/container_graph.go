@@ -3,8 +3,10 @@ package libpod
 import (
 	"context"
 	"strings"
+	"sync"
 
 	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/pkg/parallel"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -192,22 +194,41 @@ func detectCycles(graph *ContainerGraph) (bool, error) {
 
 // Visit a node on a container graph and start the container, or set an error if
 // a dependency failed to start. if restart is true, startNode will restart the node instead of starting it.
-func startNode(ctx context.Context, node *containerNode, setError bool, ctrErrors map[string]error, ctrsVisited map[string]bool, restart bool) {
+// Independent nodes (and nodes whose dependencies have all finished) are started
+// concurrently: startNode recurses into a new goroutine for each of a node's
+// dependents once that node's own work is done, while the actual container
+// start/restart is enqueued on the shared pkg/parallel job pool so the number
+// of containers started at once is bounded by the configured worker limit.
+// wg must be incremented by the caller before invoking startNode, and is
+// decremented by startNode before it returns.
+func startNode(ctx context.Context, node *containerNode, setError bool, ctrErrors map[string]error, errorsLock *sync.Mutex, ctrsVisited map[string]bool, restart bool, wg *sync.WaitGroup) {
+	defer wg.Done()
+
 	// First, check if we have already visited the node
-	if ctrsVisited[node.id] {
+	errorsLock.Lock()
+	visited := ctrsVisited[node.id]
+	errorsLock.Unlock()
+	if visited {
 		return
 	}
 
 	// If setError is true, a dependency of us failed
 	// Mark us as failed and recurse
 	if setError {
+		errorsLock.Lock()
+		if ctrsVisited[node.id] {
+			errorsLock.Unlock()
+			return
+		}
 		// Mark us as visited, and set an error
 		ctrsVisited[node.id] = true
 		ctrErrors[node.id] = errors.Wrapf(define.ErrCtrStateInvalid, "a dependency of container %s failed to start", node.id)
+		errorsLock.Unlock()
 
 		// Hit anyone who depends on us, and set errors on them too
 		for _, successor := range node.dependedOn {
-			startNode(ctx, successor, true, ctrErrors, ctrsVisited, restart)
+			wg.Add(1)
+			go startNode(ctx, successor, true, ctrErrors, errorsLock, ctrsVisited, restart, wg)
 		}
 
 		return
@@ -215,19 +236,27 @@ func startNode(ctx context.Context, node *containerNode, setError bool, ctrError
 
 	// Have all our dependencies started?
 	// If not, don't visit the node yet
+	errorsLock.Lock()
 	depsVisited := true
 	for _, dep := range node.dependsOn {
 		depsVisited = depsVisited && ctrsVisited[dep.id]
 	}
 	if !depsVisited {
+		errorsLock.Unlock()
 		// Don't visit us yet, all dependencies are not up
 		// We'll hit the dependencies eventually, and when we do it will
 		// recurse here
 		return
 	}
+	if ctrsVisited[node.id] {
+		// Another goroutine got here first.
+		errorsLock.Unlock()
+		return
+	}
 
 	// Going to try to start the container, mark us as visited
 	ctrsVisited[node.id] = true
+	errorsLock.Unlock()
 
 	ctrErrored := false
 
@@ -238,46 +267,51 @@ func startNode(ctx context.Context, node *containerNode, setError bool, ctrError
 	// the dependencies
 	depsStopped, err := node.container.checkDependenciesRunning()
 	if err != nil {
+		errorsLock.Lock()
 		ctrErrors[node.id] = err
+		errorsLock.Unlock()
 		ctrErrored = true
 	} else if len(depsStopped) > 0 {
 		// Our dependencies are not running
 		depsList := strings.Join(depsStopped, ",")
+		errorsLock.Lock()
 		ctrErrors[node.id] = errors.Wrapf(define.ErrCtrStateInvalid, "the following dependencies of container %s are not running: %s", node.id, depsList)
+		errorsLock.Unlock()
 		ctrErrored = true
 	}
 
-	// Lock before we start
-	node.container.lock.Lock()
+	// Start (or restart) the container on the shared parallel job pool, so
+	// containers with satisfied dependencies run concurrently without
+	// exceeding the configured worker limit.
+	if !ctrErrored && len(node.container.config.InitContainerType) < 1 {
+		startChan := parallel.Enqueue(ctx, func() error {
+			node.container.lock.Lock()
+			defer node.container.lock.Unlock()
 
-	// Sync the container to pick up current state
-	if !ctrErrored {
-		if err := node.container.syncContainer(); err != nil {
-			ctrErrored = true
-			ctrErrors[node.id] = err
-		}
-	}
+			// Sync the container to pick up current state
+			if err := node.container.syncContainer(); err != nil {
+				return err
+			}
 
-	// Start the container (only if it is not running)
-	if !ctrErrored && len(node.container.config.InitContainerType) < 1 {
-		if !restart && node.container.state.State != define.ContainerStateRunning {
-			if err := node.container.initAndStart(ctx); err != nil {
-				ctrErrored = true
-				ctrErrors[node.id] = err
+			if !restart && node.container.state.State != define.ContainerStateRunning {
+				return node.container.initAndStart(ctx)
 			}
-		}
-		if restart && node.container.state.State != define.ContainerStatePaused && node.container.state.State != define.ContainerStateUnknown {
-			if err := node.container.restartWithTimeout(ctx, node.container.config.StopTimeout); err != nil {
-				ctrErrored = true
-				ctrErrors[node.id] = err
+			if restart && node.container.state.State != define.ContainerStatePaused && node.container.state.State != define.ContainerStateUnknown {
+				return node.container.restartWithTimeout(ctx, node.container.config.StopTimeout)
 			}
+			return nil
+		})
+		if err := <-startChan; err != nil {
+			ctrErrored = true
+			errorsLock.Lock()
+			ctrErrors[node.id] = err
+			errorsLock.Unlock()
 		}
 	}
 
-	node.container.lock.Unlock()
-
-	// Recurse to anyone who depends on us and start them
+	// Recurse to anyone who depends on us and start them concurrently
 	for _, successor := range node.dependedOn {
-		startNode(ctx, successor, ctrErrored, ctrErrors, ctrsVisited, restart)
+		wg.Add(1)
+		go startNode(ctx, successor, ctrErrored, ctrErrors, errorsLock, ctrsVisited, restart, wg)
 	}
 }
@@ -125,26 +125,28 @@ func (c *Container) getContainerInspectData(size bool, driverData *define.Driver
 		Path:    path,
 		Args:    args,
 		State: &define.InspectContainerState{
-			OciVersion:     ctrSpec.Version,
-			Status:         runtimeInfo.State.String(),
-			Running:        runtimeInfo.State == define.ContainerStateRunning,
-			Paused:         runtimeInfo.State == define.ContainerStatePaused,
-			OOMKilled:      runtimeInfo.OOMKilled,
-			Dead:           runtimeInfo.State.String() == "bad state",
-			Pid:            runtimeInfo.PID,
-			ConmonPid:      runtimeInfo.ConmonPID,
-			ExitCode:       runtimeInfo.ExitCode,
-			Error:          "", // can't get yet
-			StartedAt:      runtimeInfo.StartedTime,
-			FinishedAt:     runtimeInfo.FinishedTime,
-			Checkpointed:   runtimeInfo.Checkpointed,
-			CgroupPath:     cgroupPath,
-			RestoredAt:     runtimeInfo.RestoredTime,
-			CheckpointedAt: runtimeInfo.CheckpointedTime,
-			Restored:       runtimeInfo.Restored,
-			CheckpointPath: runtimeInfo.CheckpointPath,
-			CheckpointLog:  runtimeInfo.CheckpointLog,
-			RestoreLog:     runtimeInfo.RestoreLog,
+			OciVersion:         ctrSpec.Version,
+			Status:             runtimeInfo.State.String(),
+			Running:            runtimeInfo.State == define.ContainerStateRunning,
+			Paused:             runtimeInfo.State == define.ContainerStatePaused,
+			OOMKilled:          runtimeInfo.OOMKilled,
+			Dead:               runtimeInfo.State.String() == "bad state",
+			Pid:                runtimeInfo.PID,
+			ConmonPid:          runtimeInfo.ConmonPID,
+			ExitCode:           runtimeInfo.ExitCode,
+			Error:              "", // can't get yet
+			StartedAt:          runtimeInfo.StartedTime,
+			FinishedAt:         runtimeInfo.FinishedTime,
+			Checkpointed:       runtimeInfo.Checkpointed,
+			CgroupPath:         cgroupPath,
+			ResourceAccounting: runtimeInfo.ResourceAccounting,
+			OOMStatus:          runtimeInfo.OOMStatus,
+			RestoredAt:         runtimeInfo.RestoredTime,
+			CheckpointedAt:     runtimeInfo.CheckpointedTime,
+			Restored:           runtimeInfo.Restored,
+			CheckpointPath:     runtimeInfo.CheckpointPath,
+			CheckpointLog:      runtimeInfo.CheckpointLog,
+			RestoreLog:         runtimeInfo.RestoreLog,
 		},
 		Image:           config.RootfsImageID,
 		ImageName:       config.RootfsImageName,
@@ -188,6 +190,10 @@ func (c *Container) getContainerInspectData(size bool, driverData *define.Driver
 		}
 	}
 
+	if c.config.SdNotifyMode == define.SdNotifyModeContainer {
+		data.State.NotifyStatus = c.getNotifyStatus()
+	}
+
 	networkConfig, err := c.getContainerNetworkInfo()
 	if err != nil {
 		return nil, err
@@ -851,6 +857,9 @@ func (c *Container) generateInspectContainerHostConfig(ctrSpec *spec.Spec, named
 		return nil, err
 	}
 
+	// Device cgroup rules
+	hostConfig.DeviceCgroupRules = getDeviceCgroupRules(ctrSpec)
+
 	// Ulimits
 	hostConfig.Ulimits = []define.InspectUlimit{}
 	if ctrSpec.Process != nil {
@@ -921,6 +930,31 @@ func (c *Container) GetDevices(priv bool, ctrSpec spec.Spec, deviceNodes map[str
 	return devices, nil
 }
 
+// getDeviceCgroupRules returns the effective set of device cgroup rules
+// applied to the container (via --device-cgroup-rule), formatted the same
+// way the flag accepts them: "type major:minor access".
+func getDeviceCgroupRules(ctrSpec *spec.Spec) []string {
+	rules := []string{}
+	if ctrSpec.Linux == nil || ctrSpec.Linux.Resources == nil {
+		return rules
+	}
+	for _, dev := range ctrSpec.Linux.Resources.Devices {
+		if !dev.Allow {
+			continue
+		}
+		major := "*"
+		if dev.Major != nil {
+			major = fmt.Sprintf("%d", *dev.Major)
+		}
+		minor := "*"
+		if dev.Minor != nil {
+			minor = fmt.Sprintf("%d", *dev.Minor)
+		}
+		rules = append(rules, fmt.Sprintf("%s %s:%s %s", dev.Type, major, minor, dev.Access))
+	}
+	return rules
+}
+
 func blkioDeviceThrottle(deviceNodes map[string]string, devs []spec.LinuxThrottleDevice) ([]define.InspectBlkioThrottleDevice, error) {
 	out := []define.InspectBlkioThrottleDevice{}
 	for _, dev := range devs {
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	metadata "github.com/checkpoint-restore/checkpointctl/lib"
@@ -21,6 +22,8 @@ import (
 	"github.com/containers/common/pkg/chown"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/libpod/events"
+	"github.com/containers/podman/v4/libpod/logs"
+	"github.com/containers/podman/v4/libpod/logs/forward"
 	"github.com/containers/podman/v4/pkg/ctime"
 	"github.com/containers/podman/v4/pkg/hooks"
 	"github.com/containers/podman/v4/pkg/hooks/exec"
@@ -215,6 +218,18 @@ func (c *Container) handleExitFile(exitFile string, fi os.FileInfo) error {
 
 	c.state.Exited = true
 
+	// Capture a final resource accounting snapshot while the container's
+	// cgroup still exists, so podman inspect and the accounting report
+	// can show what it consumed after cleanup tears the cgroup down.
+	c.state.ResourceAccounting = c.finalizeResourceAccounting()
+
+	// Likewise, capture OOM-kill and memory pressure counters so
+	// operators can see why the container died without reading dmesg.
+	c.state.OOMStatus = c.finalizeOOMStatus()
+	if c.state.OOMStatus != nil && c.state.OOMStatus.OOMKillCount > 0 {
+		c.newOOMKilledEvent(c.state.OOMStatus.OOMKillCount)
+	}
+
 	// Write an event for the container's death
 	c.newContainerExitedEvent(c.state.ExitCode)
 
@@ -901,11 +916,16 @@ func (c *Container) startDependencies(ctx context.Context) error {
 
 	ctrErrors := make(map[string]error)
 	ctrsVisited := make(map[string]bool)
+	errorsLock := new(sync.Mutex)
 
-	// Traverse the graph beginning at nodes with no dependencies
+	// Traverse the graph beginning at nodes with no dependencies, restarting
+	// containers concurrently as their dependencies are satisfied.
+	var wg sync.WaitGroup
 	for _, node := range graph.noDepNodes {
-		startNode(ctx, node, false, ctrErrors, ctrsVisited, true)
+		wg.Add(1)
+		go startNode(ctx, node, false, ctrErrors, errorsLock, ctrsVisited, true, &wg)
 	}
+	wg.Wait()
 
 	if len(ctrErrors) > 0 {
 		logrus.Errorf("Starting some container dependencies")
@@ -1273,11 +1293,64 @@ func (c *Container) start() error {
 		}
 	}
 
+	if c.config.LogForwardDriver != "" {
+		if err := c.startLogForward(); err != nil {
+			// Forwarding is a best-effort addition on top of the local
+			// log driver; a broken remote collector should not prevent
+			// the container from starting.
+			logrus.Errorf("Starting log forwarding for container %s: %v", c.ID(), err)
+		}
+	}
+
 	defer c.newContainerEvent(events.Start)
 
 	return c.save()
 }
 
+// startLogForward begins tailing the container's local log file and
+// shipping new lines to the configured remote collector. It only tracks
+// logs produced from this point onward; historical log content is not
+// forwarded. Because there is no long-lived podman daemon in the common
+// case, forwarding only lasts for the lifetime of the process that started
+// the container (e.g. it is continuous under `podman system service`, but a
+// one-shot `podman run` only forwards while attached).
+func (c *Container) startLogForward() error {
+	if c.config.LogDriver != define.JSONLogging && c.config.LogDriver != define.KubernetesLogging {
+		return errors.Errorf("log forwarding requires the %q or %q log driver, container is using %q",
+			define.JSONLogging, define.KubernetesLogging, c.config.LogDriver)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = c.ID()
+	}
+	tag := c.config.LogTag
+	if tag == "" {
+		tag = c.Name()
+	}
+	sink, err := forward.NewSink(c.config.LogForwardDriver, c.config.LogForwardAddress, tag, hostname)
+	if err != nil {
+		return err
+	}
+	buffered := forward.NewBufferedSink(sink)
+
+	logChannel := make(chan *logs.LogLine, 1024)
+	logOpts := &logs.LogOptions{Follow: true, Tail: 0, WaitGroup: new(sync.WaitGroup)}
+	if err := c.ReadLog(context.Background(), logOpts, logChannel); err != nil {
+		buffered.Close()
+		return err
+	}
+
+	go func() {
+		for line := range logChannel {
+			buffered.Enqueue(line)
+		}
+		buffered.Close()
+	}()
+
+	return nil
+}
+
 // Internal, non-locking function to stop container
 func (c *Container) stop(timeout uint) error {
 	logrus.Debugf("Stopping ctr %s (timeout %d)", c.ID(), timeout)
@@ -1894,6 +1967,11 @@ func (c *Container) cleanup(ctx context.Context) error {
 		lastError = errors.Wrapf(err, "error removing container %s network", c.ID())
 	}
 
+	// Stop and remove the sd-notify proxy, if one was started.
+	if c.config.SdNotifyMode == define.SdNotifyModeContainer {
+		c.removeNotifyProxy()
+	}
+
 	// Remove the container from the runtime, if necessary.
 	// Do this *before* unmounting storage - some runtimes (e.g. Kata)
 	// apparently object to having storage removed while the container still
@@ -0,0 +1,27 @@
+package define
+
+// CheckReport is a summary of the results of `podman system check`, which
+// cross-checks the containers-storage layers, the libpod database, and named
+// volume mountpoints for consistency.
+type CheckReport struct {
+	// OrphanStorageContainers are containers present in c/storage with no
+	// matching entry in the libpod database.
+	OrphanStorageContainers []string
+	// MissingStorageContainers are containers present in the libpod
+	// database with no matching entry in c/storage.
+	MissingStorageContainers []string
+	// MissingVolumes are named volumes present in the libpod database
+	// whose mountpoint no longer exists on disk.
+	MissingVolumes []string
+	// Repaired lists the problems above that were successfully resolved
+	// because repair was requested.
+	Repaired []string
+	// RepairErrors lists problems that repair attempted to resolve, but
+	// could not.
+	RepairErrors []string
+}
+
+// Empty returns true if the check found no inconsistencies at all.
+func (c *CheckReport) Empty() bool {
+	return len(c.OrphanStorageContainers) == 0 && len(c.MissingStorageContainers) == 0 && len(c.MissingVolumes) == 0
+}
@@ -1,5 +1,21 @@
 package define
 
+import "github.com/containers/common/libnetwork/types"
+
+// ContainerSummary is a small, denormalized snapshot of the fields "podman
+// ps" needs most often, kept in its own database bucket and updated whenever
+// a container is created, removed, or changes state. It lets a container
+// listing be served with a single bucket scan instead of opening every
+// container's own sub-bucket.
+type ContainerSummary struct {
+	ID     string              `json:"id"`
+	Name   string              `json:"name"`
+	Image  string              `json:"image"`
+	State  string              `json:"state"`
+	Ports  []types.PortMapping `json:"ports,omitempty"`
+	Labels map[string]string   `json:"labels,omitempty"`
+}
+
 // Valid restart policy types.
 const (
 	// RestartPolicyNone indicates that no restart policy has been requested
@@ -200,28 +200,37 @@ type InspectMount struct {
 // Docker, but here we see more fields that are unused (nonsensical in the
 // context of Libpod).
 type InspectContainerState struct {
-	OciVersion     string             `json:"OciVersion"`
-	Status         string             `json:"Status"`
-	Running        bool               `json:"Running"`
-	Paused         bool               `json:"Paused"`
-	Restarting     bool               `json:"Restarting"` // TODO
-	OOMKilled      bool               `json:"OOMKilled"`
-	Dead           bool               `json:"Dead"`
-	Pid            int                `json:"Pid"`
-	ConmonPid      int                `json:"ConmonPid,omitempty"`
-	ExitCode       int32              `json:"ExitCode"`
-	Error          string             `json:"Error"` // TODO
-	StartedAt      time.Time          `json:"StartedAt"`
-	FinishedAt     time.Time          `json:"FinishedAt"`
-	Health         HealthCheckResults `json:"Health,omitempty"`
-	Checkpointed   bool               `json:"Checkpointed,omitempty"`
-	CgroupPath     string             `json:"CgroupPath,omitempty"`
-	CheckpointedAt time.Time          `json:"CheckpointedAt,omitempty"`
-	RestoredAt     time.Time          `json:"RestoredAt,omitempty"`
-	CheckpointLog  string             `json:"CheckpointLog,omitempty"`
-	CheckpointPath string             `json:"CheckpointPath,omitempty"`
-	RestoreLog     string             `json:"RestoreLog,omitempty"`
-	Restored       bool               `json:"Restored,omitempty"`
+	OciVersion   string             `json:"OciVersion"`
+	Status       string             `json:"Status"`
+	Running      bool               `json:"Running"`
+	Paused       bool               `json:"Paused"`
+	Restarting   bool               `json:"Restarting"` // TODO
+	OOMKilled    bool               `json:"OOMKilled"`
+	Dead         bool               `json:"Dead"`
+	Pid          int                `json:"Pid"`
+	ConmonPid    int                `json:"ConmonPid,omitempty"`
+	ExitCode     int32              `json:"ExitCode"`
+	Error        string             `json:"Error"` // TODO
+	StartedAt    time.Time          `json:"StartedAt"`
+	FinishedAt   time.Time          `json:"FinishedAt"`
+	Health       HealthCheckResults `json:"Health,omitempty"`
+	NotifyStatus string             `json:"NotifyStatus,omitempty"`
+	Checkpointed bool               `json:"Checkpointed,omitempty"`
+	CgroupPath   string             `json:"CgroupPath,omitempty"`
+	// ResourceAccounting is a snapshot of the container's cumulative
+	// resource usage, captured just before it exited. It is only
+	// populated for containers that have exited at least once and
+	// created a cgroup.
+	ResourceAccounting *ContainerResourceAccounting `json:"ResourceAccounting,omitempty"`
+	// OOMStatus reports the container's OOM-kill and memory pressure
+	// counters, captured just before it exited.
+	OOMStatus      *ContainerOOMStatus `json:"OOMStatus,omitempty"`
+	CheckpointedAt time.Time           `json:"CheckpointedAt,omitempty"`
+	RestoredAt     time.Time           `json:"RestoredAt,omitempty"`
+	CheckpointLog  string              `json:"CheckpointLog,omitempty"`
+	CheckpointPath string              `json:"CheckpointPath,omitempty"`
+	RestoreLog     string              `json:"RestoreLog,omitempty"`
+	Restored       bool                `json:"Restored,omitempty"`
 }
 
 // Healthcheck returns the HealthCheckResults. This is used for old podman compat
@@ -261,7 +270,7 @@ type HealthCheckLog struct {
 // (if available).
 // Field names are fixed for compatibility and cannot be changed.
 // As such, silence lint warnings about them.
-//nolint
+// nolint
 type InspectContainerHostConfig struct {
 	// Binds contains an array of user-added mounts.
 	// Both volume mounts and named volumes are included.
@@ -521,6 +530,10 @@ type InspectContainerHostConfig struct {
 	// guarantee that the host path will be identical - only that the actual
 	// device will be.
 	Devices []InspectDevice `json:"Devices"`
+	// DeviceCgroupRules are additional device cgroup rules that the
+	// container has access to, formatted as
+	// "type major:minor permissions" (e.g. "c 42:* rwm").
+	DeviceCgroupRules []string `json:"DeviceCgroupRules"`
 	// DiskQuota is the maximum amount of disk space the container may use
 	// (in bytes).
 	// Presently not populated.
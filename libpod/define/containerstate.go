@@ -129,6 +129,51 @@ func (s ContainerExecStatus) String() string {
 	}
 }
 
+// ContainerResourceAccounting is a snapshot of a container's cumulative
+// resource usage, captured from its cgroup right before the container's
+// process exited. It is meant for after-the-fact accounting (e.g. batch job
+// billing), not live monitoring - use ContainerStats for that.
+type ContainerResourceAccounting struct {
+	// PeakMemUsage is the highest memory usage, in bytes, recorded for
+	// the container's cgroup over its lifetime. On cgroups without a
+	// kernel-tracked historical maximum, this falls back to the memory
+	// usage observed at exit, which may understate the true peak.
+	PeakMemUsage uint64
+	// CPUTimeNano is the total CPU time, in nanoseconds, consumed by the
+	// container over its lifetime.
+	CPUTimeNano uint64
+	// CPUSystemTimeNano is the total kernel-mode CPU time, in
+	// nanoseconds, consumed by the container over its lifetime.
+	CPUSystemTimeNano uint64
+	// BlockInput is the total number of bytes read from block devices by
+	// the container over its lifetime.
+	BlockInput uint64
+	// BlockOutput is the total number of bytes written to block devices
+	// by the container over its lifetime.
+	BlockOutput uint64
+	// NetInput is the total number of bytes sent over the network by the
+	// container over its lifetime.
+	NetInput uint64
+	// NetOutput is the total number of bytes received over the network
+	// by the container over its lifetime.
+	NetOutput uint64
+}
+
+// ContainerOOMStatus reports a container's cgroup OOM-kill and memory
+// pressure counters, captured right before the container's cgroup is torn
+// down. It lets operators see why a container died without reading dmesg.
+type ContainerOOMStatus struct {
+	// OOMKillCount is the number of times the kernel OOM-killed a
+	// process in the container's cgroup.
+	OOMKillCount uint64
+	// MemoryPressureAvg10 is the percentage of the last 10 seconds that
+	// processes in the container's cgroup were stalled waiting on
+	// memory, from the cgroup's "full" PSI line. It is 0 if the kernel
+	// does not expose memory pressure (PSI must be enabled, and is only
+	// available on cgroup v2).
+	MemoryPressureAvg10 float64
+}
+
 // ContainerStats contains the statistics information for a running container
 type ContainerStats struct {
 	AvgCPU        float64
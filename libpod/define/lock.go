@@ -0,0 +1,27 @@
+package define
+
+// LockConflict describes two or more objects that have been assigned the
+// same lock ID. This should never happen in a healthy database, and
+// indicates that the lock manager's state has fallen out of sync with the
+// container/pod/volume state - usually because two podman instances raced
+// to allocate a lock, or a crash left half-completed lock bookkeeping
+// behind.
+type LockConflict struct {
+	// LockID is the lock ID assigned to more than one object.
+	LockID uint32
+	// Owners lists the containers, pods, and volumes presently
+	// configured to use LockID.
+	Owners []string
+}
+
+// SystemLocksReport is a snapshot of the state of libpod's lock allocations
+// with respect to the containers, pods, and volumes presently in the
+// database.
+type SystemLocksReport struct {
+	// NumLocks is the total number of locks available to the lock
+	// manager.
+	NumLocks uint32
+	// Conflicts is the set of lock IDs presently assigned to more than
+	// one container, pod, or volume.
+	Conflicts []LockConflict
+}
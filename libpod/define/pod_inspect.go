@@ -3,6 +3,8 @@ package define
 import (
 	"net"
 	"time"
+
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 // InspectPodData contains detailed information on a pod's configuration and
@@ -33,6 +35,10 @@ type InspectPodData struct {
 	CgroupParent string `json:"CgroupParent,omitempty"`
 	// CgroupPath is the path to the pod's Cgroup.
 	CgroupPath string `json:"CgroupPath,omitempty"`
+	// ResourceLimits are resource limits enforced on the pod's own Cgroup,
+	// bounding the combined resource usage of all containers in the pod.
+	// Only set if CreateCgroup is true.
+	ResourceLimits *spec.LinuxResources `json:"ResourceLimits,omitempty"`
 	// CreateInfra is whether this pod will create an infra container to
 	// share namespaces.
 	CreateInfra bool
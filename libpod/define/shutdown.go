@@ -0,0 +1,16 @@
+package define
+
+// ShutdownContainerResult records the outcome of stopping a single container
+// as part of an ordered shutdown of the runtime.
+type ShutdownContainerResult struct {
+	ID    string
+	Error string
+}
+
+// ShutdownStopReport summarizes the containers stopped, and any errors
+// encountered, during an ordered shutdown of the runtime - for example, when
+// `podman system service` exits.
+type ShutdownStopReport struct {
+	Stopped []string
+	Errors  []ShutdownContainerResult
+}
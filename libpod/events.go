@@ -2,6 +2,7 @@ package libpod
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"sync"
 
@@ -19,6 +20,40 @@ func (r *Runtime) newEventer() (events.Eventer, error) {
 	return events.NewEventer(options)
 }
 
+// eventSnapshot builds a compact, point-in-time snapshot of the container to
+// embed in an event, if the runtime is configured to do so. It returns nil
+// when snapshots are disabled.
+func (c *Container) eventSnapshot() *events.ObjectSnapshot {
+	if !c.runtime.eventsSnapshot {
+		return nil
+	}
+
+	snapshot := &events.ObjectSnapshot{
+		ImageDigest: c.config.RootfsImageID,
+	}
+
+	if hash, err := json.Marshal(c.config); err != nil {
+		logrus.Debugf("Unable to hash container config for event snapshot: %v", err)
+	} else {
+		snapshot.ConfigHash = fmt.Sprintf("sha256:%x", sha256.Sum256(hash))
+	}
+
+	// Events are written while the container's lock is already held, so
+	// read the state directly rather than through the locking accessors.
+	if c.state.Exited {
+		code := int(c.state.ExitCode)
+		snapshot.ExitCode = &code
+	}
+
+	if c.config.HealthCheckConfig != nil {
+		if results, err := c.getHealthCheckLog(); err == nil {
+			snapshot.HealthStatus = results.Status
+		}
+	}
+
+	return snapshot
+}
+
 // newContainerEvent creates a new event based on a container
 func (c *Container) newContainerEvent(status events.Status) {
 	e := events.NewEvent(status)
@@ -26,6 +61,7 @@ func (c *Container) newContainerEvent(status events.Status) {
 	e.Name = c.Name()
 	e.Image = c.config.RootfsImageName
 	e.Type = events.Container
+	e.Snapshot = c.eventSnapshot()
 
 	e.Details = events.Details{
 		ID:         e.ID,
@@ -45,11 +81,27 @@ func (c *Container) newContainerExitedEvent(exitCode int32) {
 	e.Image = c.config.RootfsImageName
 	e.Type = events.Container
 	e.ContainerExitCode = int(exitCode)
+	e.Snapshot = c.eventSnapshot()
 	if err := c.runtime.eventer.Write(e); err != nil {
 		logrus.Errorf("Unable to write container exited event: %q", err)
 	}
 }
 
+// newOOMKilledEvent creates a new event recording that the kernel OOM-killed
+// one or more processes in the container's cgroup.
+func (c *Container) newOOMKilledEvent(oomKillCount uint64) {
+	e := events.NewEvent(events.OOMKilled)
+	e.ID = c.ID()
+	e.Name = c.Name()
+	e.Image = c.config.RootfsImageName
+	e.Type = events.Container
+	e.Snapshot = c.eventSnapshot()
+	e.Attributes = map[string]string{"oomKillCount": fmt.Sprintf("%d", oomKillCount)}
+	if err := c.runtime.eventer.Write(e); err != nil {
+		logrus.Errorf("Unable to write OOM killed event: %q", err)
+	}
+}
+
 // newExecDiedEvent creates a new event for an exec session's death
 func (c *Container) newExecDiedEvent(sessionID string, exitCode int) {
 	e := events.NewEvent(events.ExecDied)
@@ -58,6 +110,7 @@ func (c *Container) newExecDiedEvent(sessionID string, exitCode int) {
 	e.Image = c.config.RootfsImageName
 	e.Type = events.Container
 	e.ContainerExitCode = exitCode
+	e.Snapshot = c.eventSnapshot()
 	e.Attributes = make(map[string]string)
 	e.Attributes["execID"] = sessionID
 	if err := c.runtime.eventer.Write(e); err != nil {
@@ -65,6 +118,20 @@ func (c *Container) newExecDiedEvent(sessionID string, exitCode int) {
 	}
 }
 
+// newSeccompAuditEvent creates a new event for a syscall the container's
+// seccomp filter logged (SCMP_ACT_LOG) rather than blocked outright.
+func (c *Container) newSeccompAuditEvent(syscall string) {
+	e := events.NewEvent(events.SeccompAudit)
+	e.ID = c.ID()
+	e.Name = c.Name()
+	e.Image = c.config.RootfsImageName
+	e.Type = events.Container
+	e.Attributes = map[string]string{"syscall": syscall}
+	if err := c.runtime.eventer.Write(e); err != nil {
+		logrus.Errorf("Unable to write seccomp audit event: %q", err)
+	}
+}
+
 // netNetworkEvent creates a new event based on a network connect/disconnect
 func (c *Container) newNetworkEvent(status events.Status, netName string) {
 	e := events.NewEvent(status)
@@ -98,6 +165,20 @@ func (r *Runtime) newSystemEvent(status events.Status) {
 	}
 }
 
+// NewSystemEvent creates and writes a new event for libpod as a whole, named
+// name. It is exported so that packages outside of libpod, such as the API
+// server, can record system-level events (e.g. an authenticated client
+// connection) without direct access to the Runtime's internals.
+func (r *Runtime) NewSystemEvent(status events.Status, name string) {
+	e := events.NewEvent(status)
+	e.Type = events.System
+	e.Name = name
+
+	if err := r.eventer.Write(e); err != nil {
+		logrus.Errorf("Unable to write system event: %q", err)
+	}
+}
+
 // newVolumeEvent creates a new event for a libpod volume
 func (v *Volume) newVolumeEvent(status events.Status) {
 	e := events.NewEvent(status)
@@ -39,9 +39,31 @@ type Event struct {
 	// Type of event that occurred
 	Type Type
 
+	// Snapshot is an opt-in, compact snapshot of the affected object at
+	// the time of the event, so that consumers do not need a follow-up
+	// inspect that could race with the object's removal.
+	Snapshot *ObjectSnapshot `json:",omitempty"`
+
 	Details
 }
 
+// ObjectSnapshot is a compact, point-in-time snapshot of the object an event
+// occurred on. It is only populated when the runtime is configured to embed
+// snapshots in events, and fields that don't apply to a given event or
+// object (e.g. HealthStatus on a container without a health check) are left
+// empty.
+type ObjectSnapshot struct {
+	// ConfigHash is a hash of the container's static configuration at
+	// the time of the event.
+	ConfigHash string `json:"configHash,omitempty"`
+	// ImageDigest is the ID of the image the container was created from.
+	ImageDigest string `json:"imageDigest,omitempty"`
+	// ExitCode is the container's last known exit code.
+	ExitCode *int `json:"exitCode,omitempty"`
+	// HealthStatus is the container's last known health check status.
+	HealthStatus string `json:"healthStatus,omitempty"`
+}
+
 // Details describes specifics about certain events, specifically around
 // container events
 type Details struct {
@@ -113,6 +135,9 @@ const (
 	Attach Status = "attach"
 	// AutoUpdate ...
 	AutoUpdate Status = "auto-update"
+	// ClientAuth indicates that a client authenticated to the API
+	// service using a TLS client certificate.
+	ClientAuth Status = "client_auth"
 	// Build ...
 	Build Status = "build"
 	// Checkpoint ...
@@ -149,6 +174,12 @@ const (
 	NetworkConnect Status = "connect"
 	// NetworkDisconnect
 	NetworkDisconnect Status = "disconnect"
+	// NotifyReady indicates a container sent READY=1 over a proxied
+	// sd-notify socket.
+	NotifyReady Status = "notify_ready"
+	// OOMKilled indicates that the kernel OOM-killed one or more
+	// processes in a container's cgroup.
+	OOMKilled Status = "oom_kill"
 	// Pause ...
 	Pause Status = "pause"
 	// Prune ...
@@ -175,10 +206,16 @@ const (
 	Start Status = "start"
 	// Stop ...
 	Stop Status = "stop"
+	// SeccompAudit indicates a container's seccomp filter logged an
+	// attempt to make a blocked syscall (SCMP_ACT_LOG).
+	SeccompAudit Status = "seccomp_audit"
 	// Sync ...
 	Sync Status = "sync"
 	// Tag ...
 	Tag Status = "tag"
+	// Update indicates a container's configuration was changed while
+	// running, e.g. its device cgroup rules.
+	Update Status = "update"
 	// Unmount ...
 	Unmount Status = "unmount"
 	// Unpause ...
@@ -146,6 +146,8 @@ func StringToStatus(name string) (Status, error) {
 		return Build, nil
 	case Checkpoint.String():
 		return Checkpoint, nil
+	case ClientAuth.String():
+		return ClientAuth, nil
 	case Cleanup.String():
 		return Cleanup, nil
 	case Commit.String():
@@ -176,6 +178,8 @@ func StringToStatus(name string) (Status, error) {
 		return NetworkConnect, nil
 	case NetworkDisconnect.String():
 		return NetworkDisconnect, nil
+	case NotifyReady.String():
+		return NotifyReady, nil
 	case Pause.String():
 		return Pause, nil
 	case Prune.String():
@@ -196,6 +200,8 @@ func StringToStatus(name string) (Status, error) {
 		return Restore, nil
 	case Save.String():
 		return Save, nil
+	case SeccompAudit.String():
+		return SeccompAudit, nil
 	case Start.String():
 		return Start, nil
 	case Stop.String():
@@ -204,6 +210,8 @@ func StringToStatus(name string) (Status, error) {
 		return Sync, nil
 	case Tag.String():
 		return Tag, nil
+	case Update.String():
+		return Update, nil
 	case Unmount.String():
 		return Unmount, nil
 	case Unpause.String():
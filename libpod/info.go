@@ -21,6 +21,7 @@ import (
 	"github.com/containers/podman/v4/libpod/linkmode"
 	"github.com/containers/podman/v4/pkg/rootless"
 	"github.com/containers/storage"
+	"github.com/containers/storage/drivers/overlay"
 	"github.com/containers/storage/pkg/system"
 	"github.com/opencontainers/selinux/go-selinux"
 	"github.com/pkg/errors"
@@ -324,9 +325,33 @@ func (r *Runtime) storeInfo() (*define.StoreInfo, error) {
 		status[pair[0]] = pair[1]
 	}
 	info.GraphStatus = status
+
+	if r.store.GraphDriverName() == "overlay" && rootless.IsRootless() {
+		info.GraphStatus["Rootless Overlay Path"] = rootlessOverlayPath(graphOptions, r.store.GraphRoot(), r.store.RunRoot())
+	}
+
 	return &info, nil
 }
 
+// rootlessOverlayPath reports which mount mechanism a rootless overlay
+// storage driver is using: an explicitly configured mount_program (usually
+// fuse-overlayfs), or the kernel's native unprivileged overlay support when
+// no mount_program is set and the kernel is new enough to allow it.
+func rootlessOverlayPath(graphOptions map[string]interface{}, graphRoot, runRoot string) string {
+	if _, ok := graphOptions["overlay.mount_program"]; ok {
+		return "fuse-overlayfs (mount_program)"
+	}
+	supported, err := overlay.SupportsNativeOverlay(graphRoot, runRoot)
+	if err != nil {
+		logrus.Warnf("Failed to detect rootless native overlay support: %v", err)
+		return "unknown"
+	}
+	if supported {
+		return "native"
+	}
+	return "fuse-overlayfs"
+}
+
 func readKernelVersion() (string, error) {
 	buf, err := ioutil.ReadFile("/proc/version")
 	if err != nil {
@@ -0,0 +1,256 @@
+// Package forward ships container log lines to remote log collectors
+// (syslog, fluentd, GELF) while the local log driver (json-file or k8s-file)
+// keeps writing to disk, so `podman logs` continues to work unchanged.
+package forward
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/podman/v4/libpod/logs"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Supported log forward driver names.
+const (
+	DriverSyslog  = "syslog"
+	DriverFluentd = "fluentd"
+	DriverGELF    = "gelf"
+
+	// defaultBufferSize is the number of log lines that may be queued for
+	// a sink before new lines are dropped rather than blocking log writes.
+	defaultBufferSize = 1024
+
+	dialTimeout = 5 * time.Second
+)
+
+// Sink ships a single log line to a remote collector.
+type Sink interface {
+	Send(line *logs.LogLine) error
+	Close() error
+}
+
+// ParseAddress splits an address of the form "tcp://host:port" or
+// "udp://host:port" into its network and host:port parts. If no scheme is
+// given, defaultNetwork is used.
+func ParseAddress(address, defaultNetwork string) (network, hostport string, err error) {
+	if address == "" {
+		return "", "", errors.New("log forward address must not be empty")
+	}
+	if idx := strings.Index(address, "://"); idx != -1 {
+		return address[:idx], address[idx+3:], nil
+	}
+	return defaultNetwork, address, nil
+}
+
+// NewSink creates a Sink for the given driver ("syslog", "fluentd", "gelf")
+// that connects to address. tag identifies the container in the shipped
+// records (the container name, by convention).
+func NewSink(driver, address, tag, hostname string) (Sink, error) {
+	switch driver {
+	case DriverSyslog:
+		network, hostport, err := ParseAddress(address, "udp")
+		if err != nil {
+			return nil, err
+		}
+		return &syslogSink{conn: newReconnectingConn(network, hostport), tag: tag}, nil
+	case DriverFluentd:
+		network, hostport, err := ParseAddress(address, "tcp")
+		if err != nil {
+			return nil, err
+		}
+		return &fluentdSink{conn: newReconnectingConn(network, hostport), tag: tag}, nil
+	case DriverGELF:
+		network, hostport, err := ParseAddress(address, "udp")
+		if err != nil {
+			return nil, err
+		}
+		return &gelfSink{conn: newReconnectingConn(network, hostport), hostname: hostname}, nil
+	default:
+		return nil, errors.Errorf("unsupported log forward driver %q", driver)
+	}
+}
+
+// reconnectingConn is a net.Conn wrapper that transparently redials the
+// remote endpoint the next time Write is called after a failure, so a
+// restart of the remote collector does not require restarting the container.
+type reconnectingConn struct {
+	network, address string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func newReconnectingConn(network, address string) *reconnectingConn {
+	return &reconnectingConn{network: network, address: address}
+}
+
+func (c *reconnectingConn) Write(b []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		if err := c.dial(); err != nil {
+			return 0, err
+		}
+	}
+	if n, err := c.conn.Write(b); err == nil {
+		return n, nil
+	}
+	// The connection may have gone stale (collector restarted, network
+	// blip); redial once and retry before giving up on this line.
+	c.conn.Close()
+	c.conn = nil
+	if err := c.dial(); err != nil {
+		return 0, err
+	}
+	return c.conn.Write(b)
+}
+
+func (c *reconnectingConn) dial() error {
+	conn, err := net.DialTimeout(c.network, c.address, dialTimeout)
+	if err != nil {
+		return errors.Wrapf(err, "connecting to log forward endpoint %s://%s", c.network, c.address)
+	}
+	c.conn = conn
+	return nil
+}
+
+func (c *reconnectingConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
+
+// syslogSink formats lines as RFC 3164 syslog messages.
+type syslogSink struct {
+	conn *reconnectingConn
+	tag  string
+}
+
+func (s *syslogSink) Send(line *logs.LogLine) error {
+	priority := 14 // user.info
+	if line.Device == "stderr" {
+		priority = 11 // user.err
+	}
+	msg := fmt.Sprintf("<%d>%s %s: %s\n", priority, line.Time.Format(time.Stamp), s.tag, line.Msg)
+	_, err := s.conn.Write([]byte(msg))
+	return err
+}
+
+func (s *syslogSink) Close() error { return s.conn.Close() }
+
+// gelfSink formats lines as single-line GELF 1.1 JSON messages.
+type gelfSink struct {
+	conn     *reconnectingConn
+	hostname string
+}
+
+func (s *gelfSink) Send(line *logs.LogLine) error {
+	level := 6 // informational
+	if line.Device == "stderr" {
+		level = 3 // error
+	}
+	payload, err := json.Marshal(map[string]interface{}{
+		"version":         "1.1",
+		"host":            s.hostname,
+		"short_message":   line.Msg,
+		"timestamp":       float64(line.Time.UnixNano()) / float64(time.Second),
+		"level":           level,
+		"_container_id":   line.CID,
+		"_container_name": line.CName,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.conn.Write(append(payload, '\n'))
+	return err
+}
+
+func (s *gelfSink) Close() error { return s.conn.Close() }
+
+// fluentdSink ships lines as Fluentd Forward-protocol JSON events, in the
+// [tag, time, record] form accepted by in_forward when configured with the
+// json parser (the full msgpack Forward protocol is not implemented).
+type fluentdSink struct {
+	conn *reconnectingConn
+	tag  string
+}
+
+func (s *fluentdSink) Send(line *logs.LogLine) error {
+	record := []interface{}{
+		s.tag,
+		line.Time.Unix(),
+		map[string]string{
+			"message":        line.Msg,
+			"container_id":   line.CID,
+			"container_name": line.CName,
+			"source":         line.Device,
+		},
+	}
+	payload, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = s.conn.Write(append(payload, '\n'))
+	return err
+}
+
+func (s *fluentdSink) Close() error { return s.conn.Close() }
+
+// BufferedSink wraps a Sink with a bounded, asynchronous queue so a slow or
+// temporarily unreachable remote collector cannot block container logging.
+// Lines are dropped, with a warning, once the buffer fills.
+type BufferedSink struct {
+	sink  Sink
+	lines chan *logs.LogLine
+	done  chan struct{}
+}
+
+// NewBufferedSink starts a goroutine draining into sink and returns
+// immediately. Call Close to stop it and flush pending work.
+func NewBufferedSink(sink Sink) *BufferedSink {
+	b := &BufferedSink{
+		sink:  sink,
+		lines: make(chan *logs.LogLine, defaultBufferSize),
+		done:  make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+func (b *BufferedSink) run() {
+	defer close(b.done)
+	for line := range b.lines {
+		if err := b.sink.Send(line); err != nil {
+			logrus.Warnf("Dropping forwarded log line for container %s: %v", line.CID, err)
+		}
+	}
+}
+
+// Enqueue queues line for delivery, dropping it if the buffer is full.
+func (b *BufferedSink) Enqueue(line *logs.LogLine) {
+	select {
+	case b.lines <- line:
+	default:
+		logrus.Warnf("Log forward buffer full for container %s, dropping log line", line.CID)
+	}
+}
+
+// Close stops accepting new lines, waits for the queue to drain, and closes
+// the underlying sink.
+func (b *BufferedSink) Close() error {
+	close(b.lines)
+	<-b.done
+	return b.sink.Close()
+}
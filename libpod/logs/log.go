@@ -40,6 +40,33 @@ type LogOptions struct {
 	Multi      bool
 	WaitGroup  *sync.WaitGroup
 	UseName    bool
+	// Colors assigns each container in a multi-container log stream a
+	// distinct ANSI color for its name/ID prefix, cycling through a
+	// fixed palette keyed off the container ID.
+	Colors bool
+}
+
+// colorPalette is the sequence of ANSI foreground colors cycled through
+// when coloring multi-container log prefixes.
+var colorPalette = []string{
+	"\033[36m", // cyan
+	"\033[33m", // yellow
+	"\033[32m", // green
+	"\033[35m", // magenta
+	"\033[34m", // blue
+	"\033[31m", // red
+}
+
+const colorReset = "\033[0m"
+
+// colorFor deterministically picks a palette color for the given
+// container ID so a container keeps the same color across log lines.
+func colorFor(cid string) string {
+	var sum int
+	for _, c := range cid {
+		sum += int(c)
+	}
+	return colorPalette[sum%len(colorPalette)]
 }
 
 // LogLine describes the information for each line of a log
@@ -176,6 +203,9 @@ func (l *LogLine) String(options *LogOptions) string {
 			}
 			out = fmt.Sprintf("%s ", cid)
 		}
+		if options.Colors {
+			out = colorFor(l.CID) + strings.TrimSuffix(out, " ") + colorReset + " "
+		}
 	}
 	if options.Timestamps {
 		out += fmt.Sprintf("%s ", l.Time.Format(LogTimeFormat))
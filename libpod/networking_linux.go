@@ -1402,6 +1402,110 @@ func (c *Container) NetworkConnect(nameOrID, netName string, netOpts types.PerNe
 	return nil
 }
 
+// NetworkModify will allow you to set new options on an existing connected network.
+// To add and remove aliases, set the Aliases field of netOpts to the desired
+// end state; unlike ConnectNetwork, this replaces the existing aliases rather
+// than appending to them. If the container is running, the network interface
+// is torn down and recreated in place, without a restart, so that DNS servers
+// such as aardvark-dns or dnsname pick up the new aliases immediately.
+func (c *Container) NetworkModify(nameOrID, netName string, netOpts types.PerNetworkOptions) error {
+	// only the bridge mode supports cni networks
+	if err := isBridgeNetMode(c.config.NetMode); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	networks, err := c.networks()
+	if err != nil {
+		return err
+	}
+
+	// check if network exists and if the input is a ID we get the name
+	// CNI only uses names so it is important that we only use the name
+	netName, err = c.runtime.normalizeNetworkName(netName)
+	if err != nil {
+		return err
+	}
+
+	oldOpts, nameExists := networks[netName]
+	if !nameExists {
+		return errors.Errorf("container %s is not connected to network %s", nameOrID, netName)
+	}
+
+	// preserve everything the caller did not explicitly ask to change
+	if netOpts.InterfaceName == "" {
+		netOpts.InterfaceName = oldOpts.InterfaceName
+	}
+	if len(netOpts.StaticIPs) == 0 {
+		netOpts.StaticIPs = oldOpts.StaticIPs
+	}
+	if len(netOpts.StaticMAC) == 0 {
+		netOpts.StaticMAC = oldOpts.StaticMAC
+	}
+	// always keep the short id as alias for docker compat
+	netOpts.Aliases = append(netOpts.Aliases, c.config.ID[:12])
+
+	if err := c.syncContainer(); err != nil {
+		return err
+	}
+
+	if err := c.runtime.state.NetworkModify(c, netName, netOpts); err != nil {
+		return err
+	}
+	c.newNetworkEvent(events.NetworkConnect, netName)
+	if !c.ensureState(define.ContainerStateRunning, define.ContainerStateCreated) {
+		return nil
+	}
+	if c.state.NetNS == nil {
+		return errors.Wrapf(define.ErrNoNetwork, "unable to modify %s network %s", nameOrID, netName)
+	}
+
+	// get network status before we tear down the old configuration
+	networkStatus := c.getNetworkStatus()
+	if status, ok := networkStatus[netName]; ok {
+		// if the caller did not pin an address, keep the one the container
+		// already has so the container keeps its IP across the modification
+		if len(netOpts.StaticIPs) == 0 {
+			for _, iface := range status.Interfaces {
+				for _, subnet := range iface.Subnets {
+					netOpts.StaticIPs = append(netOpts.StaticIPs, subnet.IPNet.IP)
+				}
+			}
+		}
+	}
+
+	opts := types.NetworkOptions{
+		ContainerID:   c.config.ID,
+		ContainerName: getCNIPodName(c),
+	}
+	opts.PortMappings = c.convertPortMappings()
+	opts.Networks = map[string]types.PerNetworkOptions{
+		netName: oldOpts,
+	}
+	if err := c.runtime.teardownNetwork(c.state.NetNS.Path(), opts); err != nil {
+		return err
+	}
+
+	opts.Networks = map[string]types.PerNetworkOptions{
+		netName: netOpts,
+	}
+	results, err := c.runtime.setUpNetwork(c.state.NetNS.Path(), opts)
+	if err != nil {
+		return err
+	}
+	if len(results) != 1 {
+		return errors.New("when modifying aliases, results must be of length 1")
+	}
+
+	// update network status
+	networkStatus[netName] = results[netName]
+	c.state.NetworkStatus = networkStatus
+
+	return c.save()
+}
+
 // get a free interface name for a new network
 // return an empty string if no free name was found
 func getFreeInterfaceName(networks map[string]types.PerNetworkOptions) string {
@@ -1436,6 +1540,15 @@ func (r *Runtime) ConnectContainerToNetwork(nameOrID, netName string, netOpts ty
 	return ctr.NetworkConnect(nameOrID, netName, netOpts)
 }
 
+// ModifyContainerNetworkOptions modifies options for a specific network connected to the container.
+func (r *Runtime) ModifyContainerNetworkOptions(nameOrID, netName string, netOpts types.PerNetworkOptions) error {
+	ctr, err := r.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+	return ctr.NetworkModify(nameOrID, netName, netOpts)
+}
+
 // normalizeNetworkName takes a network name, a partial or a full network ID and returns the network name.
 // If the network is not found a errors is returned.
 func (r *Runtime) normalizeNetworkName(nameOrID string) (string, error) {
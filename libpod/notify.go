@@ -0,0 +1,53 @@
+package libpod
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+)
+
+// notifyStatusPath returns the path of the file podman uses to record the
+// last STATUS= message sent by the container over its proxied
+// NOTIFY_SOCKET.
+func (c *Container) notifyStatusPath() string {
+	return filepath.Join(c.bundlePath(), "notify-status")
+}
+
+// notifyProxySocketPath returns the path of the local socket podman listens
+// on in order to proxy sd-notify messages sent by the container's process on
+// to the host's real NOTIFY_SOCKET.
+func (c *Container) notifyProxySocketPath() string {
+	return filepath.Join(c.bundlePath(), "notify-proxy.sock")
+}
+
+// removeNotifyProxy stops the notify proxy started by startNotifyProxy, if
+// any, and removes its socket file.
+func (c *Container) removeNotifyProxy() {
+	if c.notifyProxyConn != nil {
+		if err := c.notifyProxyConn.Close(); err != nil {
+			logrus.Debugf("Error closing notify proxy for container %s: %v", c.ID(), err)
+		}
+		c.notifyProxyConn = nil
+	}
+	if err := os.Remove(c.notifyProxySocketPath()); err != nil && !os.IsNotExist(err) {
+		logrus.Debugf("Error removing notify proxy socket for container %s: %v", c.ID(), err)
+	}
+}
+
+// getNotifyStatus returns the last STATUS= message sent by the container
+// over sd-notify, or the empty string if none has been received yet.
+func (c *Container) getNotifyStatus() string {
+	status, err := ioutil.ReadFile(c.notifyStatusPath())
+	if err != nil {
+		return ""
+	}
+	return string(status)
+}
+
+// setNotifyStatus records status as the container's last sd-notify STATUS=
+// message, so it can be surfaced via "podman inspect".
+func (c *Container) setNotifyStatus(status string) error {
+	return ioutil.WriteFile(c.notifyStatusPath(), []byte(status), 0644)
+}
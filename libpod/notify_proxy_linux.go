@@ -0,0 +1,91 @@
+package libpod
+
+import (
+	"bufio"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/containers/podman/v4/libpod/events"
+	"github.com/sirupsen/logrus"
+)
+
+// startNotifyProxy binds the notify proxy socket and starts a goroutine that
+// forwards every message it receives to the container's real NOTIFY_SOCKET
+// (c.notifySocket), while also recording READY and STATUS messages on the
+// container. It is a no-op if the container has no NOTIFY_SOCKET to proxy
+// to. Multiple containers - e.g. containers sharing a pod - each get their
+// own proxy socket, all of which multiplex onto the same upstream
+// NOTIFY_SOCKET.
+func (c *Container) startNotifyProxy() error {
+	if c.notifySocket == "" {
+		return nil
+	}
+
+	sockPath := c.notifyProxySocketPath()
+	if err := os.Remove(sockPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: sockPath, Net: "unixgram"})
+	if err != nil {
+		return err
+	}
+
+	c.notifyProxyConn = conn
+	go c.runNotifyProxy(conn)
+
+	return nil
+}
+
+// runNotifyProxy reads sd-notify datagrams from conn until it is closed,
+// forwarding each one to the container's real NOTIFY_SOCKET and recording
+// READY/STATUS messages along the way.
+func (c *Container) runNotifyProxy(conn *net.UnixConn) {
+	buf := make([]byte, 4096)
+	readyReported := false
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			// The socket was closed as part of container cleanup.
+			return
+		}
+		payload := buf[:n]
+
+		if err := forwardNotifyMessage(c.notifySocket, payload); err != nil {
+			logrus.Warnf("Forwarding sd-notify message from container %s: %v", c.ID(), err)
+		}
+
+		ready := false
+		scanner := bufio.NewScanner(strings.NewReader(string(payload)))
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "READY=1":
+				ready = true
+			case strings.HasPrefix(line, "STATUS="):
+				if err := c.setNotifyStatus(strings.TrimPrefix(line, "STATUS=")); err != nil {
+					logrus.Warnf("Recording sd-notify status for container %s: %v", c.ID(), err)
+				}
+			}
+		}
+		if ready && !readyReported {
+			readyReported = true
+			c.newContainerEvent(events.NotifyReady)
+		}
+	}
+}
+
+// forwardNotifyMessage sends payload verbatim to the unix datagram socket at
+// hostSocket, the real NOTIFY_SOCKET of the podman process (or systemd, if
+// podman itself was started by systemd).
+func forwardNotifyMessage(hostSocket string, payload []byte) error {
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: hostSocket, Net: "unixgram"})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Write(payload)
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/containers/podman/v4/libpod/define"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 // OCIRuntime is an implementation of an OCI runtime.
@@ -51,6 +52,10 @@ type OCIRuntime interface {
 	PauseContainer(ctr *Container) error
 	// UnpauseContainer unpauses the given container.
 	UnpauseContainer(ctr *Container) error
+	// UpdateContainer updates the given container's cgroup resource
+	// limits in place, without a restart. Only a subset of resources -
+	// presently, device cgroup rules and block I/O limits - are supported.
+	UpdateContainer(ctr *Container, resources *spec.LinuxResources) error
 
 	// HTTPAttach performs an attach intended to be transported over HTTP.
 	// For terminal attach, the container's output will be directly streamed
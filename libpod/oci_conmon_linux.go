@@ -514,6 +514,34 @@ func (r *ConmonOCIRuntime) UnpauseContainer(ctr *Container) error {
 	return utils.ExecCmdWithStdStreams(os.Stdin, os.Stdout, os.Stderr, env, r.path, append(r.runtimeFlags, "resume", ctr.ID())...)
 }
 
+// UpdateContainer updates the given container's cgroup resource limits
+// in place by invoking the OCI runtime's update command.
+func (r *ConmonOCIRuntime) UpdateContainer(ctr *Container, resources *spec.LinuxResources) error {
+	runtimeDir, err := util.GetRuntimeDir()
+	if err != nil {
+		return err
+	}
+
+	resourcesJSON, err := json.Marshal(resources)
+	if err != nil {
+		return errors.Wrapf(err, "error marshalling resources for container %s update", ctr.ID())
+	}
+
+	f, err := ioutil.TempFile("", "podman-update-resources")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if _, err := f.Write(resourcesJSON); err != nil {
+		return errors.Wrapf(err, "error writing resources for container %s update", ctr.ID())
+	}
+
+	env := []string{fmt.Sprintf("XDG_RUNTIME_DIR=%s", runtimeDir)}
+	args := append(r.runtimeFlags, "update", "--resources", f.Name(), ctr.ID())
+	return utils.ExecCmdWithStdStreams(os.Stdin, os.Stdout, os.Stderr, env, r.path, args...)
+}
+
 // HTTPAttach performs an attach for the HTTP API.
 // The caller must handle closing the HTTP connection after this returns.
 // The cancel channel is not closed; it is up to the caller to do so after
@@ -1076,7 +1104,10 @@ func (r *ConmonOCIRuntime) createOCIContainer(ctr *Container, restoreOptions *Co
 	args := r.sharedConmonArgs(ctr, ctr.ID(), ctr.bundlePath(), pidfile, ctr.LogPath(), r.exitsDir, ociLog, ctr.LogDriver(), logTag)
 
 	if ctr.config.SdNotifyMode == define.SdNotifyModeContainer && ctr.notifySocket != "" {
-		args = append(args, fmt.Sprintf("--sdnotify-socket=%s", ctr.notifySocket))
+		if err := ctr.startNotifyProxy(); err != nil {
+			return 0, errors.Wrapf(err, "error starting notify proxy for container %s", ctr.ID())
+		}
+		args = append(args, fmt.Sprintf("--sdnotify-socket=%s", ctr.notifyProxySocketPath()))
 	}
 
 	if ctr.config.Spec.Process.Terminal {
@@ -1273,6 +1304,14 @@ func (r *ConmonOCIRuntime) createOCIContainer(ctr *Container, restoreOptions *Co
 	}
 	ctr.state.PID = pid
 
+	if !ctr.config.NoCgroups && ctr.config.CgroupsMode != "disabled" {
+		if cgroupPath, err := ctr.resolveCgroupPathForPID(pid); err != nil {
+			logrus.Debugf("Error determining cgroup path for container %s: %v", ctr.ID(), err)
+		} else {
+			ctr.state.CgroupPath = cgroupPath
+		}
+	}
+
 	conmonPID, err := readConmonPidFile(ctr.config.ConmonPidFile)
 	if err != nil {
 		logrus.Warnf("Error reading conmon pid file for container %s: %v", ctr.ID(), err)
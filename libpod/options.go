@@ -15,6 +15,7 @@ import (
 	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/libpod/events"
+	"github.com/containers/podman/v4/libpod/logs/forward"
 	"github.com/containers/podman/v4/pkg/namespaces"
 	"github.com/containers/podman/v4/pkg/rootless"
 	"github.com/containers/podman/v4/pkg/specgen"
@@ -521,6 +522,26 @@ func WithSyslog() RuntimeOption {
 	}
 }
 
+// WithSeccompAuditEvents sets a runtime option so we know whether to watch
+// the kernel audit log for SCMP_ACT_LOG seccomp hits and translate them into
+// per-container podman events.
+func WithSeccompAuditEvents() RuntimeOption {
+	return func(rt *Runtime) error {
+		rt.seccompAuditEvents = true
+		return nil
+	}
+}
+
+// WithEventsSnapshot sets a runtime option so container events include a
+// compact snapshot of the container's config and state, letting consumers
+// avoid a follow-up inspect that could race with the container's removal.
+func WithEventsSnapshot() RuntimeOption {
+	return func(rt *Runtime) error {
+		rt.eventsSnapshot = true
+		return nil
+	}
+}
+
 // WithRuntimeFlags adds the global runtime flags to the container config
 func WithRuntimeFlags(runtimeFlags []string) RuntimeOption {
 	return func(rt *Runtime) error {
@@ -1081,6 +1102,30 @@ func WithLogTag(tag string) CtrCreateOption {
 	}
 }
 
+// WithLogForwarding configures the container to additionally ship its logs
+// to a remote collector, on top of whatever local log driver is in use.
+func WithLogForwarding(driver, address string) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		switch driver {
+		case forward.DriverSyslog, forward.DriverFluentd, forward.DriverGELF:
+			break
+		default:
+			return errors.Wrapf(define.ErrInvalidArg, "invalid log forward driver %q", driver)
+		}
+		if address == "" {
+			return errors.Wrapf(define.ErrInvalidArg, "log forward address must be set")
+		}
+
+		ctr.config.LogForwardDriver = driver
+		ctr.config.LogForwardAddress = address
+
+		return nil
+	}
+}
+
 // WithCgroupsMode disables the creation of Cgroups for the conmon process.
 func WithCgroupsMode(mode string) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -1353,6 +1398,21 @@ func WithRestartRetries(tries uint) CtrCreateOption {
 	}
 }
 
+// WithCheckpointOnStop indicates that the container should be checkpointed
+// instead of killed when stopped, and restored from that checkpoint instead
+// of started cold the next time it is started.
+func WithCheckpointOnStop() CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		ctr.config.CheckpointOnStop = true
+
+		return nil
+	}
+}
+
 // WithNamedVolumes adds the given named volumes to the container.
 func WithNamedVolumes(volumes []*ContainerNamedVolume) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -1878,6 +1938,89 @@ func WithPodParent() PodCreateOption {
 	}
 }
 
+// WithPodResources sets the resource limits enforced on the pod's own
+// Cgroup, bounding the combined resource usage of all containers in the
+// pod. Only takes effect when the pod is set to create its own Cgroup (see
+// WithPodParent).
+func WithPodResources(resources specs.LinuxResources) PodCreateOption {
+	return func(pod *Pod) error {
+		if pod.valid {
+			return define.ErrPodFinalized
+		}
+
+		pod.config.ResourceLimits = &resources
+
+		return nil
+	}
+}
+
+// WithPodRestartPolicy sets the pod's restart policy, which is applied to
+// the pod's infra container to govern its (and, transitively, the pod's)
+// recreation when it exits. Valid values are "no", "on-failure", "always",
+// and "unless-stopped". The empty string is allowed, and will be equivalent
+// to "no".
+func WithPodRestartPolicy(policy string) PodCreateOption {
+	return func(pod *Pod) error {
+		if pod.valid {
+			return define.ErrPodFinalized
+		}
+
+		switch policy {
+		case define.RestartPolicyNone, define.RestartPolicyNo, define.RestartPolicyOnFailure, define.RestartPolicyAlways, define.RestartPolicyUnlessStopped:
+			pod.config.RestartPolicy = policy
+		default:
+			return errors.Wrapf(define.ErrInvalidArg, "%q is not a valid restart policy", policy)
+		}
+
+		return nil
+	}
+}
+
+// WithPodRestartRetries sets the number of retries to use when restarting
+// the pod's infra container under the "on-failure" restart policy.
+// 0 is an allowed value, and indicates infinite retries.
+func WithPodRestartRetries(tries uint) PodCreateOption {
+	return func(pod *Pod) error {
+		if pod.valid {
+			return define.ErrPodFinalized
+		}
+
+		pod.config.RestartRetries = tries
+
+		return nil
+	}
+}
+
+// WithPodVolumes sets the named volumes that will automatically be added to
+// every container joined to the pod, unless a container specifies its own
+// mount at the same destination.
+func WithPodVolumes(volumes []*ContainerNamedVolume) PodCreateOption {
+	return func(pod *Pod) error {
+		if pod.valid {
+			return define.ErrPodFinalized
+		}
+
+		pod.config.Volumes = volumes
+
+		return nil
+	}
+}
+
+// WithPodSecrets sets the secrets that will automatically be added to every
+// container joined to the pod, unless a container specifies its own secret
+// with the same target.
+func WithPodSecrets(secrets []*ContainerSecret) PodCreateOption {
+	return func(pod *Pod) error {
+		if pod.valid {
+			return define.ErrPodFinalized
+		}
+
+		pod.config.Secrets = secrets
+
+		return nil
+	}
+}
+
 // WithPodNamespace sets the namespace for the created pod.
 // Namespaces are used to create separate views of Podman's state - runtimes can
 // join a specific namespace and see only containers and pods in that namespace.
@@ -51,6 +51,10 @@ type PodConfig struct {
 	// If true, all containers joined to the pod will use the pod cgroup as
 	// their cgroup parent, and cannot set a different cgroup parent
 	UsePodCgroup bool `json:"sharesCgroup,omitempty"`
+	// ResourceLimits are resource limits enforced on the pod's own Cgroup,
+	// which bound the combined resource usage of the infra container and
+	// all containers joined to the pod. Only used when UsePodCgroup is true.
+	ResourceLimits *specs.LinuxResources `json:"resourceLimits,omitempty"`
 
 	// The following UsePod{kernelNamespace} indicate whether the containers
 	// in the pod will inherit the namespace from the first container in the pod.
@@ -64,6 +68,27 @@ type PodConfig struct {
 
 	HasInfra bool `json:"hasInfra,omitempty"`
 
+	// RestartPolicy indicates the pod-level restart policy, applied to the
+	// infra container to govern its (and, transitively, the pod's)
+	// recreation when it exits. Allowed values match container restart
+	// policies: "no", "on-failure", "always", and "unless-stopped". The empty string is
+	// treated as the default ("no").
+	RestartPolicy string `json:"restart_policy,omitempty"`
+	// RestartRetries indicates the number of attempts that will be made to
+	// restart the infra container. Used only if RestartPolicy is set to
+	// "on-failure".
+	RestartRetries uint `json:"restart_retries,omitempty"`
+
+	// Volumes are named volumes that are automatically added to every
+	// container joined to the pod, unless a container specifies its own
+	// mount at the same destination. Tracked as a pod resource: removing
+	// the pod with the appropriate option removes these volumes as well.
+	Volumes []*ContainerNamedVolume `json:"podVolumes,omitempty"`
+	// Secrets are secrets that are automatically added to every container
+	// joined to the pod, unless a container specifies its own secret with
+	// the same target.
+	Secrets []*ContainerSecret `json:"podSecrets,omitempty"`
+
 	// Time pod was created
 	CreatedTime time.Time `json:"created"`
 
@@ -242,6 +267,36 @@ func (p *Pod) CgroupParent() string {
 	return p.config.CgroupParent
 }
 
+// ResourceLimits returns the resource limits enforced on the pod's own
+// Cgroup, if any are set.
+func (p *Pod) ResourceLimits() *specs.LinuxResources {
+	return p.config.ResourceLimits
+}
+
+// RestartPolicy returns the pod's restart policy, applied to the pod's
+// infra container.
+func (p *Pod) RestartPolicy() string {
+	return p.config.RestartPolicy
+}
+
+// RestartRetries returns the number of retries used when restarting the
+// pod's infra container under the "on-failure" restart policy.
+func (p *Pod) RestartRetries() uint {
+	return p.config.RestartRetries
+}
+
+// Volumes returns the named volumes that are automatically added to every
+// container joined to the pod.
+func (p *Pod) Volumes() []*ContainerNamedVolume {
+	return p.config.Volumes
+}
+
+// Secrets returns the secrets that are automatically added to every
+// container joined to the pod.
+func (p *Pod) Secrets() []*ContainerSecret {
+	return p.config.Secrets
+}
+
 // SharesPID returns whether containers in pod
 // default to use PID namespace of first container in pod
 func (p *Pod) SharesPID() bool {
@@ -2,6 +2,7 @@ package libpod
 
 import (
 	"context"
+	"sync"
 
 	"github.com/containers/common/pkg/cgroups"
 	"github.com/containers/podman/v4/libpod/define"
@@ -96,11 +97,16 @@ func (p *Pod) Start(ctx context.Context) (map[string]error, error) {
 
 	ctrErrors := make(map[string]error)
 	ctrsVisited := make(map[string]bool)
+	errorsLock := new(sync.Mutex)
 
-	// Traverse the graph beginning at nodes with no dependencies
+	// Traverse the graph beginning at nodes with no dependencies, starting
+	// containers concurrently as their dependencies are satisfied.
+	var wg sync.WaitGroup
 	for _, node := range graph.noDepNodes {
-		startNode(ctx, node, false, ctrErrors, ctrsVisited, false)
+		wg.Add(1)
+		go startNode(ctx, node, false, ctrErrors, errorsLock, ctrsVisited, false, &wg)
 	}
+	wg.Wait()
 
 	if len(ctrErrors) > 0 {
 		return ctrErrors, errors.Wrapf(define.ErrPodPartialFail, "error starting some containers")
@@ -406,6 +412,7 @@ func (p *Pod) Restart(ctx context.Context) (map[string]error, error) {
 
 	ctrErrors := make(map[string]error)
 	ctrsVisited := make(map[string]bool)
+	errorsLock := new(sync.Mutex)
 
 	// If there are no containers without dependencies, we can't start
 	// Error out
@@ -413,10 +420,14 @@ func (p *Pod) Restart(ctx context.Context) (map[string]error, error) {
 		return nil, errors.Wrapf(define.ErrNoSuchCtr, "no containers in pod %s have no dependencies, cannot start pod", p.ID())
 	}
 
-	// Traverse the graph beginning at nodes with no dependencies
+	// Traverse the graph beginning at nodes with no dependencies, restarting
+	// containers concurrently as their dependencies are satisfied.
+	var wg sync.WaitGroup
 	for _, node := range graph.noDepNodes {
-		startNode(ctx, node, false, ctrErrors, ctrsVisited, true)
+		wg.Add(1)
+		go startNode(ctx, node, false, ctrErrors, errorsLock, ctrsVisited, true, &wg)
 	}
+	wg.Wait()
 
 	if len(ctrErrors) > 0 {
 		return ctrErrors, errors.Wrapf(define.ErrPodPartialFail, "error stopping some containers")
@@ -483,6 +494,58 @@ func (p *Pod) Kill(ctx context.Context, signal uint) (map[string]error, error) {
 	return nil, nil
 }
 
+// AddLabels adds the given labels to the pod, overwriting any existing
+// labels with the same keys.
+func (p *Pod) AddLabels(labels map[string]string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.valid {
+		return define.ErrPodRemoved
+	}
+
+	if p.config.Labels == nil {
+		p.config.Labels = make(map[string]string)
+	}
+	for k, v := range labels {
+		p.config.Labels[k] = v
+	}
+
+	return p.save()
+}
+
+// UpdateRestartPolicy changes the pod's restart policy, applying it to the
+// pod's infra container so it governs infra (and thus pod) recreation the
+// next time the infra container exits. Does not affect the infra
+// container's current run.
+func (p *Pod) UpdateRestartPolicy(policy string, retries *uint) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.valid {
+		return define.ErrPodRemoved
+	}
+
+	if p.state.InfraContainerID == "" {
+		return errors.Wrapf(define.ErrNoSuchCtr, "pod %s has no infra container to apply a restart policy to", p.ID())
+	}
+	infra, err := p.runtime.GetContainer(p.state.InfraContainerID)
+	if err != nil {
+		return errors.Wrapf(err, "unable to look up infra container for pod %s", p.ID())
+	}
+
+	if err := infra.UpdateRestartPolicy(policy, retries); err != nil {
+		return err
+	}
+
+	p.config.RestartPolicy = policy
+	if retries != nil {
+		p.config.RestartRetries = *retries
+	}
+
+	return p.save()
+}
+
 // Status gets the status of all containers in the pod.
 // Returns a map of Container ID to Container Status.
 func (p *Pod) Status() (map[string]define.ContainerStatus, error) {
@@ -667,6 +730,7 @@ func (p *Pod) Inspect() (*define.InspectPodData, error) {
 		CreateCgroup:       p.config.UsePodCgroup,
 		CgroupParent:       p.CgroupParent(),
 		CgroupPath:         p.state.CgroupPath,
+		ResourceLimits:     p.config.ResourceLimits,
 		CreateInfra:        infraConfig != nil,
 		InfraContainerID:   p.state.InfraContainerID,
 		InfraConfig:        infraConfig,
@@ -86,6 +86,16 @@ type Runtime struct {
 	libimageEventsShutdown chan bool
 	lockManager            lock.Manager
 
+	// seccompAuditEvents indicates that the runtime should watch the
+	// kernel audit log for SCMP_ACT_LOG seccomp hits and translate them
+	// into per-container podman events.
+	seccompAuditEvents         bool
+	seccompAuditEventsShutdown chan bool
+
+	// eventsSnapshot indicates that container events should embed a
+	// compact snapshot of the container's config and state.
+	eventsSnapshot bool
+
 	// syslog describes whenever logrus should log to the syslog as well.
 	// Note that the syslog hook will be enabled early in cmd/podman/syslog_linux.go
 	// This bool is just needed so that we can set it for netavark interface.
@@ -318,7 +328,7 @@ func makeRuntime(ctx context.Context, runtime *Runtime) (retErr error) {
 	case config.InMemoryStateStore:
 		return errors.Wrapf(define.ErrInvalidArg, "in-memory state is currently disabled")
 	case config.SQLiteStateStore:
-		return errors.Wrapf(define.ErrInvalidArg, "SQLite state is currently disabled")
+		return errors.Wrapf(define.ErrInvalidArg, "SQLite state is currently disabled: this build of podman was not compiled with SQLite support")
 	case config.BoltDBStateStore:
 		dbPath := filepath.Join(runtime.config.Engine.StaticDir, "bolt_state.db")
 
@@ -837,6 +847,9 @@ func (r *Runtime) Shutdown(force bool) error {
 		if r.libimageEventsShutdown != nil {
 			r.libimageEventsShutdown <- true
 		}
+		if r.seccompAuditEventsShutdown != nil {
+			r.seccompAuditEventsShutdown <- true
+		}
 
 		// Note that the libimage runtime shuts down the store.
 		if err := r.libimageRuntime.Shutdown(force); err != nil {
@@ -968,6 +981,10 @@ func (r *Runtime) configureStore() error {
 	// Run the libimage events routine.
 	r.libimageEvents()
 
+	if r.seccompAuditEvents {
+		r.seccompAuditEventsWatch()
+	}
+
 	return nil
 }
 
@@ -0,0 +1,91 @@
+package libpod
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/storage"
+	"github.com/pkg/errors"
+)
+
+// Check cross-checks the containers-storage layers, the libpod database, and
+// named volume mountpoints for consistency, optionally repairing anything it
+// finds broken.
+//
+// Repair is limited to actions that are already safe, existing operations
+// elsewhere in Podman (evicting containers, deleting orphaned storage) -
+// Check does not attempt any repair that could plausibly destroy data that
+// is still good.
+func (r *Runtime) Check(ctx context.Context, repair bool) (*define.CheckReport, error) {
+	report := new(define.CheckReport)
+
+	storageCtrs, err := r.ListStorageContainers()
+	if err != nil {
+		return nil, err
+	}
+	for _, ctr := range storageCtrs {
+		if ctr.PresentInLibpod {
+			continue
+		}
+		report.OrphanStorageContainers = append(report.OrphanStorageContainers, ctr.ID)
+		if !repair {
+			continue
+		}
+		if err := r.RemoveStorageContainer(ctr.ID, true); err != nil {
+			report.RepairErrors = append(report.RepairErrors, fmt.Sprintf("removing orphan storage container %s: %v", ctr.ID, err))
+			continue
+		}
+		report.Repaired = append(report.Repaired, fmt.Sprintf("removed orphan storage container %s", ctr.ID))
+	}
+
+	ctrs, err := r.state.AllContainers()
+	if err != nil {
+		return nil, err
+	}
+	for _, ctr := range ctrs {
+		if _, err := r.store.Container(ctr.ID()); err != nil {
+			if errors.Cause(err) != storage.ErrContainerUnknown {
+				return nil, errors.Wrapf(err, "looking up storage for container %s", ctr.ID())
+			}
+			report.MissingStorageContainers = append(report.MissingStorageContainers, ctr.ID())
+			if !repair {
+				continue
+			}
+			if _, err := r.evictContainer(ctx, ctr.ID(), false); err != nil {
+				report.RepairErrors = append(report.RepairErrors, fmt.Sprintf("evicting container %s with missing storage: %v", ctr.ID(), err))
+				continue
+			}
+			report.Repaired = append(report.Repaired, fmt.Sprintf("evicted container %s with missing storage", ctr.ID()))
+		}
+	}
+
+	vols, err := r.state.AllVolumes()
+	if err != nil {
+		return nil, err
+	}
+	for _, vol := range vols {
+		if vol.config.Driver != "" && vol.config.Driver != define.VolumeDriverLocal {
+			// Non-local drivers manage their own storage; we have no
+			// way to check them here.
+			continue
+		}
+		if _, err := os.Stat(vol.config.MountPoint); err != nil {
+			if !os.IsNotExist(err) {
+				return nil, errors.Wrapf(err, "checking mountpoint for volume %s", vol.Name())
+			}
+			report.MissingVolumes = append(report.MissingVolumes, vol.Name())
+			if !repair {
+				continue
+			}
+			// Recreating a missing mountpoint could silently discard
+			// whatever the user expected to find there, so we do not
+			// do it automatically - report it and let the user decide
+			// with `podman volume rm`.
+			report.RepairErrors = append(report.RepairErrors, fmt.Sprintf("volume %s is missing its mountpoint; remove it manually with `podman volume rm`", vol.Name()))
+		}
+	}
+
+	return report, nil
+}
@@ -528,6 +528,18 @@ func (r *Runtime) setupContainer(ctx context.Context, ctr *Container) (_ *Contai
 		if err := r.state.AddContainerToPod(pod, ctr); err != nil {
 			return nil, err
 		}
+
+		// Pods without an infra container still need a container to own
+		// their shared namespaces if any were requested. The first
+		// container joined to the pod claims that role, exactly as an
+		// infra container would; later members resolve it the same way
+		// via Pod.InfraContainerID.
+		if !pod.config.HasInfra && pod.state.InfraContainerID == "" && (pod.SharesNamespaces() || pod.SharesCgroup()) {
+			pod.state.InfraContainerID = ctr.ID()
+			if err := pod.save(); err != nil {
+				return nil, err
+			}
+		}
 	} else if err := r.state.AddContainer(ctr); err != nil {
 		return nil, err
 	}
@@ -1041,6 +1053,14 @@ func (r *Runtime) GetAllContainers() ([]*Container, error) {
 	return r.state.AllContainers()
 }
 
+// GetContainerSummaries returns a small, denormalized summary of every
+// container known to the runtime, without opening each container's own
+// database entry. If force is true, the cached summaries are bypassed and
+// rebuilt from each container's authoritative config and state instead.
+func (r *Runtime) GetContainerSummaries(force bool) ([]define.ContainerSummary, error) {
+	return r.state.AllContainerSummaries(force)
+}
+
 // GetRunningContainers is a helper function for GetContainers
 func (r *Runtime) GetRunningContainers() ([]*Container, error) {
 	running := func(c *Container) bool {
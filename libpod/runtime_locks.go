@@ -0,0 +1,53 @@
+package libpod
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v4/libpod/define"
+)
+
+// LocksDiagnose examines the lock ID recorded against every container, pod,
+// and volume in the database and reports any lock ID that has been assigned
+// to more than one object.
+//
+// It does not attempt to determine whether individual locks are presently
+// held, as that would require blocking on them - exactly the kind of hang
+// this is meant to help diagnose.
+func (r *Runtime) LocksDiagnose() (*define.SystemLocksReport, error) {
+	owners := make(map[uint32][]string)
+
+	ctrs, err := r.state.AllContainers()
+	if err != nil {
+		return nil, err
+	}
+	for _, ctr := range ctrs {
+		owners[ctr.config.LockID] = append(owners[ctr.config.LockID], fmt.Sprintf("container %s", ctr.ID()))
+	}
+
+	pods, err := r.state.AllPods()
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods {
+		owners[pod.config.LockID] = append(owners[pod.config.LockID], fmt.Sprintf("pod %s", pod.ID()))
+	}
+
+	vols, err := r.state.AllVolumes()
+	if err != nil {
+		return nil, err
+	}
+	for _, vol := range vols {
+		owners[vol.config.LockID] = append(owners[vol.config.LockID], fmt.Sprintf("volume %s", vol.Name()))
+	}
+
+	report := &define.SystemLocksReport{
+		NumLocks: r.config.Engine.NumLocks,
+	}
+	for id, names := range owners {
+		if len(names) > 1 {
+			report.Conflicts = append(report.Conflicts, define.LockConflict{LockID: id, Owners: names})
+		}
+	}
+
+	return report, nil
+}
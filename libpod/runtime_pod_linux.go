@@ -74,12 +74,19 @@ func (r *Runtime) NewPod(ctx context.Context, p specgen.PodSpecGenerator, option
 				// If we are set to use pod cgroups, set the cgroup parent that
 				// all containers in the pod will share
 				// No need to create it with cgroupfs - the first container to
-				// launch should do it for us
+				// launch should do it for us, unless we have pod-level resource
+				// limits to enforce, in which case we create it ourselves so the
+				// limits are in place before any container starts.
 				if pod.config.UsePodCgroup {
 					pod.state.CgroupPath = filepath.Join(pod.config.CgroupParent, pod.ID())
 					if p.InfraContainerSpec != nil {
 						p.InfraContainerSpec.CgroupParent = pod.state.CgroupPath
 					}
+					if pod.config.ResourceLimits != nil {
+						if err := createPodCgroup(pod.state.CgroupPath, pod.config.ResourceLimits); err != nil {
+							return nil, errors.Wrapf(err, "unable to create pod cgroup for pod %s", pod.ID())
+						}
+					}
 				}
 			}
 		case config.SystemdCgroupsManager:
@@ -103,6 +110,15 @@ func (r *Runtime) NewPod(ctx context.Context, p specgen.PodSpecGenerator, option
 				if p.InfraContainerSpec != nil {
 					p.InfraContainerSpec.CgroupParent = pod.state.CgroupPath
 				}
+				if pod.config.ResourceLimits != nil {
+					control, err := cgroups.Load(pod.state.CgroupPath)
+					if err != nil {
+						return nil, errors.Wrapf(err, "unable to load pod cgroup %s for pod %s", pod.state.CgroupPath, pod.ID())
+					}
+					if err := control.Update(pod.config.ResourceLimits); err != nil {
+						return nil, errors.Wrapf(err, "unable to apply resource limits to pod %s", pod.ID())
+					}
+				}
 			}
 		default:
 			return nil, errors.Wrapf(define.ErrInvalidArg, "unsupported Cgroup manager: %s - cannot validate cgroup parent", r.config.Engine.CgroupManager)
@@ -150,6 +166,46 @@ func (r *Runtime) NewPod(ctx context.Context, p specgen.PodSpecGenerator, option
 	return pod, nil
 }
 
+// createPodCgroup creates the given cgroupfs path with the given resource
+// limits already applied, so containers created under it inherit the pod's
+// resource ceiling from the moment they start.
+func createPodCgroup(path string, resources *spec.LinuxResources) error {
+	control, err := cgroups.New(path, resources)
+	if err != nil {
+		return err
+	}
+	return control.Update(resources)
+}
+
+// Update applies new resource limits to the pod's own Cgroup, changing the
+// ceiling enforced on all containers in the pod without requiring a restart.
+// Returns an error if the pod was not created with its own Cgroup (see
+// WithPodParent).
+func (p *Pod) Update(resources *spec.LinuxResources) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.valid {
+		return define.ErrPodRemoved
+	}
+
+	if !p.config.UsePodCgroup || p.state.CgroupPath == "" {
+		return errors.Wrapf(define.ErrNoCgroups, "pod %s does not have its own Cgroup, cannot update resource limits", p.ID())
+	}
+
+	control, err := cgroups.Load(p.state.CgroupPath)
+	if err != nil {
+		return errors.Wrapf(err, "unable to load pod cgroup %s for pod %s", p.state.CgroupPath, p.ID())
+	}
+	if err := control.Update(resources); err != nil {
+		return errors.Wrapf(err, "unable to update resource limits for pod %s", p.ID())
+	}
+
+	p.config.ResourceLimits = resources
+
+	return p.save()
+}
+
 // AddInfra adds the created infra container to the pod state
 func (r *Runtime) AddInfra(ctx context.Context, pod *Pod, infraCtr *Container) (*Pod, error) {
 	if !r.valid {
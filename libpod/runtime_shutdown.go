@@ -0,0 +1,111 @@
+package libpod
+
+import (
+	"context"
+
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/sirupsen/logrus"
+)
+
+// StopRunningContainers stops every running container known to this runtime,
+// in reverse dependency order (a container that depends on another is
+// stopped before the container it depends on), using each container's own
+// configured stop timeout. It is intended for use during an orderly shutdown
+// of `podman system service`, so dependent containers are not left behind as
+// conmon orphans when the service exits.
+func (r *Runtime) StopRunningContainers(ctx context.Context) (*define.ShutdownStopReport, error) {
+	ctrs, err := r.state.AllContainers()
+	if err != nil {
+		return nil, err
+	}
+
+	running := make([]*Container, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		state, err := ctr.State()
+		if err != nil {
+			continue
+		}
+		if state == define.ContainerStateRunning {
+			running = append(running, ctr)
+		}
+	}
+
+	report := new(define.ShutdownStopReport)
+	if len(running) == 0 {
+		return report, nil
+	}
+
+	graph, err := BuildContainerGraph(running)
+	if err != nil {
+		// Dependency information is unusable (e.g. a cycle); fall back
+		// to stopping every running container independently rather
+		// than not stopping anything at all.
+		logrus.Warnf("Could not build container dependency graph for shutdown, stopping containers independently: %v", err)
+		for _, ctr := range running {
+			stopContainerForShutdown(ctr, report)
+		}
+		return report, nil
+	}
+
+	for _, layer := range reverseDependencyOrder(graph) {
+		for _, ctr := range layer {
+			stopContainerForShutdown(ctr, report)
+		}
+	}
+
+	return report, nil
+}
+
+func stopContainerForShutdown(ctr *Container, report *define.ShutdownStopReport) {
+	if err := ctr.StopWithTimeout(ctr.config.StopTimeout); err != nil {
+		report.Errors = append(report.Errors, define.ShutdownContainerResult{ID: ctr.ID(), Error: err.Error()})
+		return
+	}
+	report.Stopped = append(report.Stopped, ctr.ID())
+}
+
+// reverseDependencyOrder returns the containers of a dependency graph
+// grouped into layers, such that every container in a layer depends on only
+// containers in later layers (or on nothing at all). Stopping the layers in
+// order therefore stops containers before anything they depend on.
+func reverseDependencyOrder(graph *ContainerGraph) [][]*Container {
+	deps := graph.DependencyMap()
+
+	remaining := make(map[*Container][]*Container, len(deps))
+	dependents := make(map[*Container]int, len(deps))
+	for ctr, dependsOn := range deps {
+		remaining[ctr] = dependsOn
+	}
+	for _, dependsOn := range deps {
+		for _, dep := range dependsOn {
+			dependents[dep]++
+		}
+	}
+
+	var order [][]*Container
+	for len(remaining) > 0 {
+		var layer []*Container
+		for ctr := range remaining {
+			if dependents[ctr] == 0 {
+				layer = append(layer, ctr)
+			}
+		}
+		if len(layer) == 0 {
+			// A cycle slipped through graph construction; stop
+			// whatever is left in one final layer rather than
+			// looping forever.
+			for ctr := range remaining {
+				layer = append(layer, ctr)
+			}
+		}
+		for _, ctr := range layer {
+			for _, dep := range remaining[ctr] {
+				dependents[dep]--
+			}
+			delete(remaining, ctr)
+		}
+		order = append(order, layer)
+	}
+
+	return order
+}
@@ -0,0 +1,98 @@
+//go:build linux
+// +build linux
+
+package libpod
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// seccompAuditLogPath is where the kernel audit daemon logs SECCOMP records.
+const seccompAuditLogPath = "/var/log/audit/audit.log"
+
+var (
+	seccompAuditLineRegex    = regexp.MustCompile(`type=SECCOMP\b`)
+	seccompAuditPidRegex     = regexp.MustCompile(`\bpid=(\d+)\b`)
+	seccompAuditSyscallRegex = regexp.MustCompile(`\bsyscall=(\S+)\b`)
+)
+
+// seccompAuditEventsWatch spawns a goroutine that tails the kernel audit log
+// for SCMP_ACT_LOG seccomp hits and, for every one it can attribute to a
+// running container, writes a SeccompAudit podman event.
+func (r *Runtime) seccompAuditEventsWatch() {
+	r.seccompAuditEventsShutdown = make(chan bool)
+
+	go func() {
+		f, err := os.Open(seccompAuditLogPath)
+		if err != nil {
+			logrus.Infof("Seccomp audit events requested but %s could not be opened: %v", seccompAuditLogPath, err)
+			return
+		}
+		defer f.Close()
+
+		// Only report syscalls logged after Podman started watching.
+		if _, err := f.Seek(0, io.SeekEnd); err != nil {
+			logrus.Errorf("Seeking to end of %s: %v", seccompAuditLogPath, err)
+			return
+		}
+		reader := bufio.NewReader(f)
+
+		for {
+			select {
+			case <-r.seccompAuditEventsShutdown:
+				return
+			default:
+			}
+
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				time.Sleep(time.Second)
+				continue
+			}
+			r.handleSeccompAuditLine(line)
+		}
+	}()
+}
+
+// handleSeccompAuditLine parses a single audit log line and, if it is a
+// SECCOMP record for a process belonging to one of our containers, writes a
+// SeccompAudit event for that container.
+func (r *Runtime) handleSeccompAuditLine(line string) {
+	if !seccompAuditLineRegex.MatchString(line) {
+		return
+	}
+	pidMatch := seccompAuditPidRegex.FindStringSubmatch(line)
+	if pidMatch == nil {
+		return
+	}
+	syscall := "unknown"
+	if syscallMatch := seccompAuditSyscallRegex.FindStringSubmatch(line); syscallMatch != nil {
+		syscall = syscallMatch[1]
+	}
+
+	cgroup, err := ioutil.ReadFile(fmt.Sprintf("/proc/%s/cgroup", pidMatch[1]))
+	if err != nil {
+		// The process is most likely gone already; nothing to attribute.
+		return
+	}
+
+	ctrs, err := r.GetAllContainers()
+	if err != nil {
+		return
+	}
+	for _, ctr := range ctrs {
+		if strings.Contains(string(cgroup), "libpod-"+ctr.ID()) {
+			ctr.newSeccompAuditEvent(syscall)
+			return
+		}
+	}
+}
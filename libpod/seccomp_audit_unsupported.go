@@ -0,0 +1,8 @@
+//go:build !linux
+// +build !linux
+
+package libpod
+
+// seccompAuditEventsWatch is a no-op outside Linux, since seccomp and the
+// kernel audit log it reports to are both Linux-specific.
+func (r *Runtime) seccompAuditEventsWatch() {}
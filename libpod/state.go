@@ -1,6 +1,9 @@
 package libpod
 
-import "github.com/containers/common/libnetwork/types"
+import (
+	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v4/libpod/define"
+)
 
 // State is a storage backend for libpod's current state.
 // A State is only initialized once per instance of libpod.
@@ -99,11 +102,21 @@ type State interface {
 	// If a namespace is set, only containers within the namespace will be
 	// returned.
 	AllContainers() ([]*Container, error)
+	// AllContainerSummaries returns a small, denormalized summary of every
+	// container presently in state, read from a dedicated summary table
+	// instead of each container's own config and state. If force is true,
+	// the summary table is bypassed and summaries are rebuilt from each
+	// container's authoritative config and state instead.
+	// If a namespace is set, only containers within the namespace will be
+	// returned.
+	AllContainerSummaries(force bool) ([]define.ContainerSummary, error)
 
 	// Get networks the container is currently connected to.
 	GetNetworks(ctr *Container) (map[string]types.PerNetworkOptions, error)
 	// Add the container to the given network with the given options
 	NetworkConnect(ctr *Container, network string, opts types.PerNetworkOptions) error
+	// NetworkModify will allow you to set new options on an existing connected network
+	NetworkModify(ctr *Container, network string, opts types.PerNetworkOptions) error
 	// Remove the container from the given network, removing all aliases for
 	// the container in that network in the process.
 	NetworkDisconnect(ctr *Container, network string) error
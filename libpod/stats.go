@@ -4,7 +4,10 @@
 package libpod
 
 import (
+	"io/ioutil"
 	"math"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -12,8 +15,15 @@ import (
 	"github.com/containers/common/pkg/cgroups"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
+// cgroupFsRoot mirrors the root cgroupfs is mounted at by
+// github.com/containers/common/pkg/cgroups, which does not export it. It is
+// only used to reach kernel-tracked historical counters (e.g. peak memory)
+// that package does not expose through its own Stat() API.
+const cgroupFsRoot = "/sys/fs/cgroup"
+
 // GetContainerStats gets the running stats for a given container.
 // The previousStats is used to correctly calculate cpu percentages. You
 // should pass nil if there is no previous stat for this container.
@@ -130,7 +140,9 @@ func (c *Container) getMemLimit() uint64 {
 
 // calculateCPUPercent calculates the cpu usage using the latest measurement in stats.
 // previousCPU is the last value of stats.CPU.Usage.Total measured at the time previousSystem.
-//  (now - previousSystem) is the time delta in nanoseconds, between the measurement in previousCPU
+//
+//	(now - previousSystem) is the time delta in nanoseconds, between the measurement in previousCPU
+//
 // and the updated value in stats.
 func calculateCPUPercent(stats *cgroups.Metrics, previousCPU, now, previousSystem uint64) float64 {
 	var (
@@ -156,3 +168,136 @@ func calculateBlockIO(stats *cgroups.Metrics) (read uint64, write uint64) {
 	}
 	return
 }
+
+// finalizeResourceAccounting captures a final snapshot of the container's
+// cumulative resource usage from its cgroup, for persisting into the
+// container's state once it exits. It must be called before the container's
+// cgroup is removed, and returns nil (logging the reason) if the container
+// created no cgroup or a snapshot could not be taken.
+func (c *Container) finalizeResourceAccounting() *define.ContainerResourceAccounting {
+	if c.config.NoCgroups || c.state.CgroupPath == "" {
+		return nil
+	}
+
+	cgroup, err := cgroups.Load(c.state.CgroupPath)
+	if err != nil {
+		logrus.Debugf("Unable to load cgroup %s to finalize resource accounting for container %s: %v", c.state.CgroupPath, c.ID(), err)
+		return nil
+	}
+	cgroupStats, err := cgroup.Stat()
+	if err != nil {
+		logrus.Debugf("Unable to stat cgroup %s to finalize resource accounting for container %s: %v", c.state.CgroupPath, c.ID(), err)
+		return nil
+	}
+
+	accounting := &define.ContainerResourceAccounting{
+		PeakMemUsage:      c.peakMemUsage(cgroupStats.Memory.Usage.Usage),
+		CPUTimeNano:       cgroupStats.CPU.Usage.Total,
+		CPUSystemTimeNano: cgroupStats.CPU.Usage.Kernel,
+	}
+	accounting.BlockInput, accounting.BlockOutput = calculateBlockIO(cgroupStats)
+
+	if netStats, err := getContainerNetIO(c); err != nil {
+		logrus.Debugf("Unable to read network stats to finalize resource accounting for container %s: %v", c.ID(), err)
+	} else if netStats != nil {
+		accounting.NetInput = netStats.TxBytes
+		accounting.NetOutput = netStats.RxBytes
+	}
+
+	return accounting
+}
+
+// peakMemUsage returns the highest memory usage the kernel recorded for the
+// container's cgroup, falling back to fallbackUsage (the usage observed at
+// the time of the final stat) if no kernel-tracked historical maximum is
+// available.
+func (c *Container) peakMemUsage(fallbackUsage uint64) uint64 {
+	contents, err := ioutil.ReadFile(c.memoryControllerFile("memory.peak", "memory.max_usage_in_bytes"))
+	if err != nil {
+		return fallbackUsage
+	}
+	peak, err := strconv.ParseUint(strings.TrimSpace(string(contents)), 10, 64)
+	if err != nil {
+		return fallbackUsage
+	}
+
+	return peak
+}
+
+// memoryControllerFile returns the path of a file in the container's memory
+// cgroup, using cgroup2Name under the unified hierarchy or cgroup1Name under
+// the v1 memory controller's hierarchy.
+func (c *Container) memoryControllerFile(cgroup2Name, cgroup1Name string) string {
+	cgroup2, err := cgroups.IsCgroup2UnifiedMode()
+	if err != nil || cgroup2 {
+		return filepath.Join(cgroupFsRoot, c.state.CgroupPath, cgroup2Name)
+	}
+	return filepath.Join(cgroupFsRoot, "memory", c.state.CgroupPath, cgroup1Name)
+}
+
+// finalizeOOMStatus captures the container's cgroup OOM-kill and memory
+// pressure counters, for persisting into the container's state once it
+// exits. It must be called before the container's cgroup is removed, and
+// returns nil if the container created no cgroup, or if the OOM kill count
+// could not be read and the container is not already marked as OOM-killed.
+func (c *Container) finalizeOOMStatus() *define.ContainerOOMStatus {
+	if c.config.NoCgroups || c.state.CgroupPath == "" {
+		return nil
+	}
+
+	// memory.events (cgroup v2) and memory.oom_control (cgroup v1, on
+	// kernels new enough to report it) both expose an "oom_kill N" line.
+	oomKillCount, err := readCgroupKeyAsUint64(c.memoryControllerFile("memory.events", "memory.oom_control"), "oom_kill")
+	if err != nil {
+		logrus.Debugf("Unable to read OOM kill count for container %s: %v", c.ID(), err)
+		if !c.state.OOMKilled {
+			return nil
+		}
+	}
+
+	status := &define.ContainerOOMStatus{OOMKillCount: oomKillCount}
+
+	// memory.pressure only exists on cgroup v2 kernels with PSI enabled,
+	// and has no v1 equivalent.
+	if contents, err := ioutil.ReadFile(c.memoryControllerFile("memory.pressure", "")); err == nil {
+		status.MemoryPressureAvg10 = parsePSIFullAvg10(contents)
+	}
+
+	return status
+}
+
+// readCgroupKeyAsUint64 reads a cgroup interface file of "key value" lines
+// (one per line) and returns the value for the given key.
+func readCgroupKeyAsUint64(path, key string) (uint64, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(string(contents), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == key {
+			return strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+	return 0, errors.Errorf("key %q not found in %s", key, path)
+}
+
+// parsePSIFullAvg10 extracts avg10 from the "full" line of a PSI file
+// (e.g. "full avg10=1.50 avg60=0.00 avg300=0.00 total=123456"), returning 0
+// if the line or field cannot be found.
+func parsePSIFullAvg10(contents []byte) float64 {
+	for _, line := range strings.Split(string(contents), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != "full" {
+			continue
+		}
+		for _, field := range fields[1:] {
+			if avg10 := strings.TrimPrefix(field, "avg10="); avg10 != field {
+				if value, err := strconv.ParseFloat(avg10, 64); err == nil {
+					return value
+				}
+			}
+		}
+	}
+	return 0
+}
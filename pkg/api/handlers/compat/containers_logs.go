@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -20,6 +21,11 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// logGrepMatchesTrailer is the HTTP trailer set on libpod log responses to
+// report how many lines matched a --grep/--grep-invert filter, so remote
+// clients don't need to count lines client-side.
+const logGrepMatchesTrailer = "X-Podman-Log-Grep-Matches"
+
 func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
@@ -32,6 +38,8 @@ func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 		Until      string `schema:"until"`
 		Timestamps bool   `schema:"timestamps"`
 		Tail       string `schema:"tail"`
+		Grep       string `schema:"grep"`
+		GrepInvert bool   `schema:"grepinvert"`
 	}{
 		Tail: "all",
 	}
@@ -40,6 +48,16 @@ func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var grepRegex *regexp.Regexp
+	if query.Grep != "" {
+		re, err := regexp.Compile(query.Grep)
+		if err != nil {
+			utils.BadRequest(w, "grep", query.Grep, errors.Wrap(err, "invalid RE2 regular expression"))
+			return
+		}
+		grepRegex = re
+	}
+
 	if !(query.Stdout || query.Stderr) {
 		msg := fmt.Sprintf("%s: you must choose at least one stream", http.StatusText(http.StatusBadRequest))
 		utils.Error(w, http.StatusBadRequest, errors.Errorf("%s for %s", msg, r.URL.String()))
@@ -104,8 +122,13 @@ func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 		close(logChannel)
 	}()
 
+	if grepRegex != nil {
+		w.Header().Set("Trailer", logGrepMatchesTrailer)
+	}
 	w.WriteHeader(http.StatusOK)
 
+	var grepMatches int
+
 	var frame strings.Builder
 	header := make([]byte, 8)
 
@@ -127,6 +150,13 @@ func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 
+		if grepRegex != nil {
+			if grepRegex.MatchString(line.Msg) == query.GrepInvert {
+				continue
+			}
+			grepMatches++
+		}
+
 		// Reset buffer we're ready to loop again
 		frame.Reset()
 		switch line.Device {
@@ -171,4 +201,8 @@ func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 			flusher.Flush()
 		}
 	}
+
+	if grepRegex != nil {
+		w.Header().Set(logGrepMatchesTrailer, strconv.Itoa(grepMatches))
+	}
 }
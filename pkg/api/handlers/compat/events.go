@@ -1,6 +1,7 @@
 package compat
 
 import (
+	"io"
 	"net/http"
 
 	"github.com/containers/podman/v4/libpod"
@@ -63,16 +64,27 @@ func GetEvents(w http.ResponseWriter, r *http.Request) {
 		errorChannel <- runtime.Events(r.Context(), readOpts)
 	}()
 
+	var out io.Writer = w
+	if utils.IsWebSocketRequest(r) {
+		wsWriter, err := utils.UpgradeToWebSocket(w, r)
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		defer wsWriter.Close()
+		out = wsWriter
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+	}
+
 	var flush = func() {}
-	if flusher, ok := w.(http.Flusher); ok {
+	if flusher, ok := out.(interface{ Flush() }); ok {
 		flush = flusher.Flush
 	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
 	flush()
 
-	coder := json.NewEncoder(w)
+	coder := json.NewEncoder(out)
 	coder.SetEscapeHTML(true)
 
 	for {
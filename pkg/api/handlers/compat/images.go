@@ -415,6 +415,8 @@ func GetImages(w http.ResponseWriter, r *http.Request) {
 		All     bool
 		Digests bool
 		Filter  string // Docker 1.24 compatibility
+		Limit   int    `schema:"limit"`
+		Offset  int    `schema:"offset"`
 	}{
 		// This is where you can override the golang default value for one of fields
 	}
@@ -444,6 +446,12 @@ func GetImages(w http.ResponseWriter, r *http.Request) {
 	imageEngine := abi.ImageEngine{Libpod: runtime}
 
 	listOptions := entities.ImageListOptions{All: query.All, Filter: filterList}
+	if utils.IsLibpodRequest(r) {
+		// Limit/offset pagination is a libpod extension; the compat
+		// endpoint has no notion of it.
+		listOptions.Limit = query.Limit
+		listOptions.Offset = query.Offset
+	}
 	summaries, err := imageEngine.List(r.Context(), listOptions)
 	if err != nil {
 		utils.Error(w, http.StatusInternalServerError, err)
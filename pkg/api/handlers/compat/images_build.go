@@ -598,24 +598,34 @@ func BuildImage(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
+	var body io.Writer = w
+	if utils.IsWebSocketRequest(r) {
+		wsWriter, err := utils.UpgradeToWebSocket(w, r)
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		defer wsWriter.Close()
+		body = wsWriter
+	} else {
+		// Send headers and prime client for stream to come
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+	}
+
 	flush := func() {
-		if flusher, ok := w.(http.Flusher); ok {
+		if flusher, ok := body.(interface{ Flush() }); ok {
 			flusher.Flush()
 		}
 	}
-
-	// Send headers and prime client for stream to come
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
 	flush()
 
-	body := w.(io.Writer)
 	if logrus.IsLevelEnabled(logrus.DebugLevel) {
 		if v, found := os.LookupEnv("PODMAN_RETAIN_BUILD_ARTIFACT"); found {
 			if keep, _ := strconv.ParseBool(v); keep {
 				t, _ := ioutil.TempFile("", "build_*_server")
 				defer t.Close()
-				body = io.MultiWriter(t, w)
+				body = io.MultiWriter(t, body)
 			}
 		}
 	}
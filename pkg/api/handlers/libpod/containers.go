@@ -67,6 +67,7 @@ func ListContainers(w http.ResponseWriter, r *http.Request) {
 		Last      int  `schema:"last"` // alias for limit
 		Limit     int  `schema:"limit"`
 		Namespace bool `schema:"namespace"`
+		Offset    int  `schema:"offset"`
 		Size      bool `schema:"size"`
 		Sync      bool `schema:"sync"`
 	}{
@@ -104,6 +105,7 @@ func ListContainers(w http.ResponseWriter, r *http.Request) {
 		Filters:   *filterMap,
 		Last:      limit,
 		Namespace: query.Namespace,
+		Offset:    query.Offset,
 		// Always return Pod, should not be part of the API.
 		// https://github.com/containers/podman/pull/7223
 		Pod:  true,
@@ -216,8 +218,9 @@ func Checkpoint(w http.ResponseWriter, r *http.Request) {
 		IgnoreRootFS   bool `schema:"ignoreRootFS"`
 		PrintStats     bool `schema:"printStats"`
 		PreCheckpoint  bool `schema:"preCheckpoint"`
-		WithPrevious   bool `schema:"withPrevious"`
-		FileLocks      bool `schema:"fileLocks"`
+		WithPrevious   bool   `schema:"withPrevious"`
+		FileLocks      bool   `schema:"fileLocks"`
+		CreateImage    string `schema:"createImage"`
 	}{
 		// override any golang type defaults
 	}
@@ -243,6 +246,7 @@ func Checkpoint(w http.ResponseWriter, r *http.Request) {
 		PreCheckPoint:  query.PreCheckpoint,
 		WithPrevious:   query.WithPrevious,
 		FileLocks:      query.FileLocks,
+		CreateImage:    query.CreateImage,
 	}
 
 	if query.Export {
@@ -304,6 +308,7 @@ func Restore(w http.ResponseWriter, r *http.Request) {
 		PrintStats      bool   `schema:"printStats"`
 		FileLocks       bool   `schema:"fileLocks"`
 		PublishPorts    string `schema:"publishPorts"`
+		ImportImage     string `schema:"importImage"`
 	}{
 		// override any golang type defaults
 	}
@@ -323,10 +328,14 @@ func Restore(w http.ResponseWriter, r *http.Request) {
 		PrintStats:      query.PrintStats,
 		FileLocks:       query.FileLocks,
 		PublishPorts:    strings.Fields(query.PublishPorts),
+		ImportImage:     query.ImportImage,
 	}
 
 	var names []string
-	if query.Import {
+	if query.ImportImage != "" {
+		// Nothing further to stage: the server resolves and restores
+		// directly from local/pulled image storage.
+	} else if query.Import {
 		t, err := ioutil.TempFile("", "restore")
 		if err != nil {
 			utils.InternalServerError(w, err)
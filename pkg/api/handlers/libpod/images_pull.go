@@ -3,6 +3,7 @@ package libpod
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 
 	"github.com/containers/common/libimage"
@@ -100,17 +101,28 @@ func ImagesPull(w http.ResponseWriter, r *http.Request) {
 		pulledImages, pullError = runtime.LibimageRuntime().Pull(runCtx, query.Reference, pullPolicy, pullOptions)
 	}()
 
+	var out io.Writer = w
+	if utils.IsWebSocketRequest(r) {
+		wsWriter, err := utils.UpgradeToWebSocket(w, r)
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		defer wsWriter.Close()
+		out = wsWriter
+	} else {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+	}
+
 	flush := func() {
-		if flusher, ok := w.(http.Flusher); ok {
+		if flusher, ok := out.(interface{ Flush() }); ok {
 			flusher.Flush()
 		}
 	}
-
-	w.WriteHeader(http.StatusOK)
-	w.Header().Set("Content-Type", "application/json")
 	flush()
 
-	enc := json.NewEncoder(w)
+	enc := json.NewEncoder(out)
 	enc.SetEscapeHTML(true)
 	for {
 		var report entities.ImagePullReport
@@ -163,6 +163,39 @@ func Connect(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, http.StatusOK, "OK")
 }
 
+// Update adds and/or removes network-scoped DNS aliases for a container
+// already connected to the given network.
+func Update(w http.ResponseWriter, r *http.Request) {
+	if v, err := utils.SupportedVersion(r, ">=4.0.0"); err != nil {
+		utils.BadRequest(w, "version", v.String(), err)
+		return
+	}
+
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	var netUpdate entities.NetworkUpdateOptions
+	if err := json.NewDecoder(r.Body).Decode(&netUpdate); err != nil {
+		utils.Error(w, http.StatusInternalServerError, errors.Wrap(err, "failed to decode request JSON payload"))
+		return
+	}
+	name := utils.GetName(r)
+
+	ic := abi.ContainerEngine{Libpod: runtime}
+	err := ic.NetworkUpdate(r.Context(), name, netUpdate)
+	if err != nil {
+		if errors.Cause(err) == define.ErrNoSuchCtr {
+			utils.ContainerNotFound(w, netUpdate.Container, err)
+			return
+		}
+		if errors.Cause(err) == define.ErrNoSuchNetwork {
+			utils.Error(w, http.StatusNotFound, err)
+			return
+		}
+		utils.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, "OK")
+}
+
 // ExistsNetwork check if a network exists
 func ExistsNetwork(w http.ResponseWriter, r *http.Request) {
 	if v, err := utils.SupportedVersion(r, ">=4.0.0"); err != nil {
@@ -139,6 +139,12 @@ type swagNetworkConnectRequest struct {
 	entities.NetworkConnectOptions
 }
 
+// Network update
+// swagger:model NetworkUpdateRequest
+type swagNetworkUpdateRequest struct {
+	entities.NetworkUpdateOptions
+}
+
 func ServeSwagger(w http.ResponseWriter, r *http.Request) {
 	path := DefaultPodmanSwaggerSpec
 	if p, found := os.LookupEnv("PODMAN_SWAGGER_SPEC"); found {
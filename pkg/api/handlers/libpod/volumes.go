@@ -109,37 +109,34 @@ func InspectVolume(w http.ResponseWriter, r *http.Request) {
 func ListVolumes(w http.ResponseWriter, r *http.Request) {
 	var (
 		runtime = r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+		decoder = r.Context().Value(api.DecoderKey).(*schema.Decoder)
 	)
-	filterMap, err := util.PrepareFilters(r)
-	if err != nil {
-		utils.Error(w, http.StatusInternalServerError,
-			errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+	query := struct {
+		Limit  int `schema:"limit"`
+		Offset int `schema:"offset"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
 		return
 	}
 
-	volumeFilters, err := filters.GenerateVolumeFilters(*filterMap)
+	filterMap, err := util.PrepareFilters(r)
 	if err != nil {
-		utils.InternalServerError(w, err)
+		utils.Error(w, http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
 		return
 	}
 
-	vols, err := runtime.Volumes(volumeFilters...)
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	volumeConfigs, err := containerEngine.VolumeList(r.Context(), entities.VolumeListOptions{
+		Filter: *filterMap,
+		Limit:  query.Limit,
+		Offset: query.Offset,
+	})
 	if err != nil {
 		utils.InternalServerError(w, err)
 		return
 	}
-	volumeConfigs := make([]*entities.VolumeListReport, 0, len(vols))
-	for _, v := range vols {
-		inspectOut, err := v.Inspect()
-		if err != nil {
-			utils.InternalServerError(w, err)
-			return
-		}
-		config := entities.VolumeConfigResponse{
-			InspectVolumeData: *inspectOut,
-		}
-		volumeConfigs = append(volumeConfigs, &entities.VolumeListReport{VolumeConfigResponse: config})
-	}
 	utils.WriteResponse(w, http.StatusOK, volumeConfigs)
 }
 
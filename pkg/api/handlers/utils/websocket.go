@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// websocketGUID is the fixed key defined by RFC 6455 used to compute the
+// Sec-WebSocket-Accept response header from a client's Sec-WebSocket-Key.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// IsWebSocketRequest reports whether r is asking to be upgraded to a
+// WebSocket connection, letting streaming endpoints (events, build output,
+// pull progress) offer a WebSocket variant browser dashboards can consume
+// directly, alongside their existing chunked-transfer response.
+func IsWebSocketRequest(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") &&
+		strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade")
+}
+
+// WebSocketWriter streams payloads to a client as WebSocket text frames. It
+// implements io.Writer, so it can be used anywhere a handler already writes
+// its streaming response to an io.Writer.
+type WebSocketWriter struct {
+	conn net.Conn
+	bw   *bufio.Writer
+}
+
+// UpgradeToWebSocket performs the WebSocket opening handshake (RFC 6455)
+// over the connection backing w, returning a writer that frames every
+// Write() call as one text message. The caller must Close() the returned
+// writer when done streaming.
+func UpgradeToWebSocket(w http.ResponseWriter, r *http.Request) (*WebSocketWriter, error) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		return nil, errors.New("missing Sec-WebSocket-Key header")
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, errors.New("connection does not support hijacking")
+	}
+	conn, buf, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+
+	accept := sha1.Sum([]byte(key + websocketGUID))
+	response := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + base64.StdEncoding.EncodeToString(accept[:]) + "\r\n\r\n"
+	if _, err := buf.WriteString(response); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := buf.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &WebSocketWriter{conn: conn, bw: bufio.NewWriter(conn)}, nil
+}
+
+// Write sends b as a single, unmasked WebSocket text frame.
+func (w *WebSocketWriter) Write(b []byte) (int, error) {
+	if err := w.writeFrame(0x1, b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// Flush satisfies http.Flusher; each Write is already sent as a complete
+// frame, so there is nothing to do.
+func (w *WebSocketWriter) Flush() {}
+
+// Close sends a close frame and closes the underlying connection.
+func (w *WebSocketWriter) Close() error {
+	_ = w.writeFrame(0x8, nil)
+	return w.conn.Close()
+}
+
+func (w *WebSocketWriter) writeFrame(opcode byte, payload []byte) error {
+	header := []byte{0x80 | opcode}
+	length := len(payload)
+	switch {
+	case length <= 125:
+		header = append(header, byte(length))
+	case length <= 65535:
+		header = append(header, 126, byte(length>>8), byte(length))
+	default:
+		header = append(header, 127,
+			byte(length>>56), byte(length>>48), byte(length>>40), byte(length>>32),
+			byte(length>>24), byte(length>>16), byte(length>>8), byte(length))
+	}
+	if _, err := w.bw.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.bw.Write(payload); err != nil {
+		return err
+	}
+	return w.bw.Flush()
+}
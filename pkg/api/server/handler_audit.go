@@ -0,0 +1,82 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+)
+
+// auditEntry is a single structured record written to the audit log for
+// every request handled by the API service.
+type auditEntry struct {
+	Time       time.Time `json:"time"`
+	RemoteAddr string    `json:"remoteAddr"`
+	User       string    `json:"user,omitempty"`
+	Method     string    `json:"method"`
+	Path       string    `json:"path"`
+	Query      string    `json:"query,omitempty"`
+	Status     int       `json:"status"`
+}
+
+// statusRecorder wraps a http.ResponseWriter to capture the status code
+// written by downstream handlers.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// auditLogHandler appends a structured JSON entry to path for every request,
+// recording the caller, endpoint, parameters and result. Failures to open
+// the log file are logged once and auditing is disabled for the life of the
+// server rather than failing requests.
+func auditLogHandler(path string) mux.MiddlewareFunc {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		logrus.Errorf("Unable to open audit log %q, auditing disabled: %v", path, err)
+		f = nil
+	}
+
+	var mu sync.Mutex
+	var enc *json.Encoder
+	if f != nil {
+		enc = json.NewEncoder(f)
+	}
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			h.ServeHTTP(rec, r)
+
+			if enc == nil {
+				return
+			}
+
+			user, _, _ := r.BasicAuth()
+			entry := auditEntry{
+				Time:       time.Now(),
+				RemoteAddr: r.RemoteAddr,
+				User:       user,
+				Method:     r.Method,
+				Path:       r.URL.Path,
+				Query:      r.URL.RawQuery,
+				Status:     rec.status,
+			}
+
+			mu.Lock()
+			if err := enc.Encode(entry); err != nil {
+				logrus.Errorf("Unable to write audit log entry: %v", err)
+			}
+			mu.Unlock()
+		})
+	}
+}
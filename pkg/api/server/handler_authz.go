@@ -0,0 +1,182 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/containers/podman/v4/pkg/api/handlers/utils"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// authzRequest is sent to an authorization plugin so it may decide whether
+// to allow or deny the request. It mirrors the request half of Docker's
+// authorization plugin protocol so existing plugins can be reused.
+type authzRequest struct {
+	User           string            `json:"User"`
+	RequestMethod  string            `json:"RequestMethod"`
+	RequestURI     string            `json:"RequestUri"`
+	RequestBody    []byte            `json:"RequestBody,omitempty"`
+	RequestHeaders map[string]string `json:"RequestHeaders,omitempty"`
+}
+
+// authzResponse is the decision returned by an authorization plugin.
+type authzResponse struct {
+	Allow bool   `json:"Allow"`
+	Msg   string `json:"Msg,omitempty"`
+	Err   string `json:"Err,omitempty"`
+}
+
+// authzRule is a single entry of a local authorization policy file, matched
+// in order against incoming requests. The first matching rule decides the
+// outcome; if no rule matches, the request is allowed.
+type authzRule struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Allow  bool   `json:"allow"`
+}
+
+// loadAuthzPolicy reads a local authorization policy file, a JSON array of
+// authzRule objects evaluated top to bottom.
+func loadAuthzPolicy(path string) ([]authzRule, error) {
+	f, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading authorization policy %q: %w", path, err)
+	}
+	var rules []authzRule
+	if err := json.Unmarshal(f, &rules); err != nil {
+		return nil, fmt.Errorf("parsing authorization policy %q: %w", path, err)
+	}
+	return rules, nil
+}
+
+// matchAuthzPolicy evaluates rules against an inbound request's method and
+// path, returning whether the request is allowed and, when denied, a
+// message describing the rule that denied it.
+func matchAuthzPolicy(rules []authzRule, method, requestPath string) (bool, string) {
+	for _, rule := range rules {
+		if rule.Method != "" && !strings.EqualFold(rule.Method, method) {
+			continue
+		}
+		if rule.Path != "" {
+			if ok, err := path.Match(rule.Path, requestPath); err != nil || !ok {
+				continue
+			}
+		}
+		if !rule.Allow {
+			return false, fmt.Sprintf("denied by policy rule %s %s", rule.Method, rule.Path)
+		}
+		return true, ""
+	}
+	return true, ""
+}
+
+// queryAuthzPlugin sends req to the authorization plugin listening on the
+// given unix socket, returning its decision.
+func queryAuthzPlugin(socketPath string, req authzRequest) (authzResponse, error) {
+	client := http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+	defer client.CloseIdleConnections()
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return authzResponse{}, err
+	}
+
+	resp, err := client.Post("http://authz-plugin/AuthZPlugin.AuthZReq", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return authzResponse{}, fmt.Errorf("contacting authorization plugin: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var res authzResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return authzResponse{}, fmt.Errorf("decoding authorization plugin response: %w", err)
+	}
+	if res.Err != "" {
+		return res, errors.New(res.Err)
+	}
+	return res, nil
+}
+
+// authorizationHandler enforces the authorization plugin and/or local
+// policy file configured in opts, denying requests that either rejects.
+// A request is allowed whenever neither an authorization plugin nor a
+// policy file has been configured.
+func authorizationHandler(opts entities.ServiceOptions) mux.MiddlewareFunc {
+	var rules []authzRule
+	var policyErr error
+	if opts.AuthzPolicyFile != "" {
+		loaded, err := loadAuthzPolicy(opts.AuthzPolicyFile)
+		if err != nil {
+			logrus.Errorf("Unable to load authorization policy, all requests will be denied: %v", err)
+			policyErr = err
+		}
+		rules = loaded
+	}
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if opts.AuthzPolicyFile != "" {
+				if policyErr != nil {
+					utils.Error(w, http.StatusForbidden, policyErr)
+					return
+				}
+				if allow, msg := matchAuthzPolicy(rules, r.Method, r.URL.Path); !allow {
+					logrus.Infof("Request denied by authorization policy: %s %s: %s", r.Method, r.URL.Path, msg)
+					utils.Error(w, http.StatusForbidden, errors.New(msg))
+					return
+				}
+			}
+
+			if opts.AuthzPlugin != "" {
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					utils.InternalServerError(w, err)
+					return
+				}
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+				headers := make(map[string]string, len(r.Header))
+				for k := range r.Header {
+					headers[k] = r.Header.Get(k)
+				}
+
+				user, _, _ := r.BasicAuth()
+				res, err := queryAuthzPlugin(opts.AuthzPlugin, authzRequest{
+					User:           user,
+					RequestMethod:  r.Method,
+					RequestURI:     r.URL.RequestURI(),
+					RequestBody:    body,
+					RequestHeaders: headers,
+				})
+				if err != nil {
+					logrus.Errorf("Authorization plugin request failed: %v", err)
+					utils.InternalServerError(w, err)
+					return
+				}
+				if !res.Allow {
+					logrus.Infof("Request denied by authorization plugin: %s %s: %s", r.Method, r.URL.Path, res.Msg)
+					utils.Error(w, http.StatusForbidden, errors.New(res.Msg))
+					return
+				}
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
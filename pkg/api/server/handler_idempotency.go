@@ -0,0 +1,127 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// idempotentResponse is a recorded response, replayed verbatim when the
+// same Idempotency-Key is seen again within the tracking window.
+type idempotentResponse struct {
+	status  int
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+// idempotencyStore tracks recently seen Idempotency-Key header values so
+// that retried create/start/remove requests replay the original result
+// instead of being executed a second time.
+type idempotencyStore struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*idempotentResponse
+}
+
+func newIdempotencyStore(window time.Duration) *idempotencyStore {
+	return &idempotencyStore{
+		window:  window,
+		entries: make(map[string]*idempotentResponse),
+	}
+}
+
+// lookup returns the recorded response for key, if any and not expired,
+// evicting it if it has expired.
+func (s *idempotencyStore) lookup(key string) (*idempotentResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(res.expires) {
+		delete(s.entries, key)
+		return nil, false
+	}
+	return res, true
+}
+
+// store records res under key for the tracking window, and opportunistically
+// evicts other expired entries so the map does not grow unbounded.
+func (s *idempotencyStore) store(key string, res *idempotentResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for k, v := range s.entries {
+		if now.After(v.expires) {
+			delete(s.entries, k)
+		}
+	}
+	s.entries[key] = res
+}
+
+// responseRecorder buffers a handler's response so it can both be sent to
+// the current client and stored for replay to future retries.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// idempotencyHandler makes the API service honor an Idempotency-Key request
+// header: the first request bearing a given key is executed normally and
+// its response cached for window; subsequent requests with the same key
+// within that window get the cached response replayed rather than being
+// executed again.
+func idempotencyHandler(window time.Duration) mux.MiddlewareFunc {
+	store := newIdempotencyStore(window)
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get("Idempotency-Key")
+			if key == "" {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			if cached, ok := store.lookup(key); ok {
+				for k, values := range cached.header {
+					for _, v := range values {
+						w.Header().Add(k, v)
+					}
+				}
+				w.Header().Set("Idempotency-Replayed", "true")
+				w.WriteHeader(cached.status)
+				_, _ = w.Write(cached.body)
+				return
+			}
+
+			rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+			h.ServeHTTP(rec, r)
+
+			store.store(key, &idempotentResponse{
+				status:  rec.status,
+				header:  w.Header().Clone(),
+				body:    append([]byte(nil), rec.body.Bytes()...),
+				expires: time.Now().Add(window),
+			})
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIdempotencyStoreLookupExpires(t *testing.T) {
+	store := newIdempotencyStore(time.Minute)
+
+	store.store("key", &idempotentResponse{
+		status:  http.StatusOK,
+		header:  http.Header{},
+		body:    []byte("first"),
+		expires: time.Now().Add(-time.Second),
+	})
+
+	if _, ok := store.lookup("key"); ok {
+		t.Errorf("lookup returned an expired entry")
+	}
+	if _, ok := store.lookup("key"); ok {
+		t.Errorf("expired entry was not evicted on lookup")
+	}
+}
+
+func TestIdempotencyHandlerReplaysWithinWindow(t *testing.T) {
+	calls := 0
+	inner := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("X-Call-Count", "1")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("created"))
+	})
+
+	handler := idempotencyHandler(time.Minute)(inner)
+
+	req := httptest.NewRequest(http.MethodPost, "/v4.0.0/libpod/containers/create", nil)
+	req.Header.Set("Idempotency-Key", "abc")
+
+	first := httptest.NewRecorder()
+	handler.ServeHTTP(first, req)
+	if first.Code != http.StatusCreated {
+		t.Fatalf("first request: got status %d, want %d", first.Code, http.StatusCreated)
+	}
+	if calls != 1 {
+		t.Fatalf("first request: inner handler called %d times, want 1", calls)
+	}
+
+	second := httptest.NewRecorder()
+	handler.ServeHTTP(second, req)
+	if calls != 1 {
+		t.Errorf("second request: inner handler called again, want the cached response replayed")
+	}
+	if second.Code != http.StatusCreated {
+		t.Errorf("second request: got status %d, want %d", second.Code, http.StatusCreated)
+	}
+	if second.Body.String() != "created" {
+		t.Errorf("second request: got body %q, want %q", second.Body.String(), "created")
+	}
+	if second.Header().Get("Idempotency-Replayed") != "true" {
+		t.Errorf("second request: missing Idempotency-Replayed header")
+	}
+}
+
+func TestIdempotencyHandlerSkipsRequestsWithoutKey(t *testing.T) {
+	calls := 0
+	inner := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusOK)
+	})
+
+	handler := idempotencyHandler(time.Minute)(inner)
+
+	req := httptest.NewRequest(http.MethodPost, "/v4.0.0/libpod/containers/create", nil)
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if calls != 2 {
+		t.Errorf("inner handler called %d times, want 2 for requests with no Idempotency-Key", calls)
+	}
+}
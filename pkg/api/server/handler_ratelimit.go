@@ -0,0 +1,87 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/containers/podman/v4/pkg/api/handlers/utils"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+)
+
+// clientBucket is a token bucket tracking the requests allowed for a single
+// client, refilled at rate tokens per second up to a burst of rate tokens.
+type clientBucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+// rateLimiter enforces a maximum number of requests per second for each
+// client, identified by remote address.
+type rateLimiter struct {
+	rate float64
+
+	mu      sync.Mutex
+	buckets map[string]*clientBucket
+}
+
+func newRateLimiter(requestsPerSecond uint) *rateLimiter {
+	return &rateLimiter{
+		rate:    float64(requestsPerSecond),
+		buckets: make(map[string]*clientBucket),
+	}
+}
+
+// allow reports whether a request from client may proceed, consuming a
+// token from its bucket if so.
+func (l *rateLimiter) allow(client string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	b, ok := l.buckets[client]
+	if !ok {
+		b = &clientBucket{tokens: l.rate, lastSeen: now}
+		l.buckets[client] = b
+	}
+
+	elapsed := now.Sub(b.lastSeen).Seconds()
+	b.lastSeen = now
+	b.tokens += elapsed * l.rate
+	if b.tokens > l.rate {
+		b.tokens = l.rate
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// rateLimitHandler rejects requests once a client has exceeded
+// requestsPerSecond requests per second. A limit of 0 disables the check.
+func rateLimitHandler(requestsPerSecond uint) mux.MiddlewareFunc {
+	limiter := newRateLimiter(requestsPerSecond)
+
+	return func(h http.Handler) http.Handler {
+		if requestsPerSecond == 0 {
+			return h
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			client := r.RemoteAddr
+			if host, _, err := net.SplitHostPort(client); err == nil {
+				client = host
+			}
+
+			if !limiter.allow(client) {
+				utils.Error(w, http.StatusTooManyRequests, errors.Errorf("rate limit of %d requests/second exceeded", requestsPerSecond))
+				return
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
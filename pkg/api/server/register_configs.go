@@ -0,0 +1,104 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v4/pkg/api/handlers/compat"
+	"github.com/gorilla/mux"
+)
+
+// registerConfigsHandlers maps Docker's swarm-mode /configs endpoints onto
+// podman secrets, since podman has no separate "config" concept and the two
+// resources share the same wire shape (ID, Version, CreatedAt/UpdatedAt,
+// Spec). This lets tooling written against the Docker SDK that expects a
+// working /configs API (rather than a 404 or 503) keep functioning.
+func (s *APIServer) registerConfigsHandlers(r *mux.Router) error {
+	// swagger:operation GET /configs compat ConfigList
+	// ---
+	// tags:
+	//  - configs (compat)
+	// summary: List configs
+	// description: Returns a list of configs. For API compatibility podman
+	//   maps this onto its own secrets, since it has no configs of its own.
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     "$ref": "#/responses/SecretListCompatResponse"
+	//   '500':
+	//      "$ref": "#/responses/InternalError"
+	r.Handle(VersionedPath("/configs"), s.APIHandler(compat.ListSecrets)).Methods(http.MethodGet)
+	r.Handle("/configs", s.APIHandler(compat.ListSecrets)).Methods(http.MethodGet)
+	// swagger:operation POST /configs/create compat ConfigCreate
+	// ---
+	// tags:
+	//  - configs (compat)
+	// summary: Create a config
+	// description: Creates a podman secret and returns it as a config.
+	// parameters:
+	//  - in: body
+	//    name: create
+	//    description: |
+	//      attributes for creating a config
+	//    schema:
+	//      $ref: "#/definitions/SecretCreate"
+	// produces:
+	// - application/json
+	// responses:
+	//   '201':
+	//     $ref: "#/responses/SecretCreateResponse"
+	//   '409':
+	//     "$ref": "#/responses/SecretInUse"
+	//   '500':
+	//      "$ref": "#/responses/InternalError"
+	r.Handle(VersionedPath("/configs/create"), s.APIHandler(compat.CreateSecret)).Methods(http.MethodPost)
+	r.Handle("/configs/create", s.APIHandler(compat.CreateSecret)).Methods(http.MethodPost)
+	// swagger:operation GET /configs/{name} compat ConfigInspect
+	// ---
+	// tags:
+	//  - configs (compat)
+	// summary: Inspect config
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the config
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     "$ref": "#/responses/SecretInspectCompatResponse"
+	//   '404':
+	//     "$ref": "#/responses/NoSuchSecret"
+	//   '500':
+	//     "$ref": "#/responses/InternalError"
+	r.Handle(VersionedPath("/configs/{name}"), s.APIHandler(compat.InspectSecret)).Methods(http.MethodGet)
+	r.Handle("/configs/{name}", s.APIHandler(compat.InspectSecret)).Methods(http.MethodGet)
+	// swagger:operation DELETE /configs/{name} compat ConfigDelete
+	// ---
+	// tags:
+	//  - configs (compat)
+	// summary: Remove config
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the config
+	// produces:
+	// - application/json
+	// responses:
+	//   '204':
+	//     description: no error
+	//   '404':
+	//     "$ref": "#/responses/NoSuchSecret"
+	//   '500':
+	//     "$ref": "#/responses/InternalError"
+	r.Handle(VersionedPath("/configs/{name}"), s.APIHandler(compat.RemoveSecret)).Methods(http.MethodDelete)
+	r.Handle("/configs/{name}", s.APIHandler(compat.RemoveSecret)).Methods(http.MethodDelete)
+
+	r.Handle(VersionedPath("/configs/{name}/update"), s.APIHandler(compat.UpdateSecret)).Methods(http.MethodPost)
+	r.Handle("/configs/{name}/update", s.APIHandler(compat.UpdateSecret)).Methods(http.MethodPost)
+	return nil
+}
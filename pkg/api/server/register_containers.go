@@ -716,6 +716,10 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    description: Return this number of most recently created containers, including non-running ones.
 	//    type: integer
 	//  - in: query
+	//    name: offset
+	//    description: Skip this number of most recently created containers, including non-running ones, before limit is applied.
+	//    type: integer
+	//  - in: query
 	//    name: namespace
 	//    type: boolean
 	//    description: Include namespace information
@@ -1460,6 +1464,10 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    name: printStats
 	//    type: boolean
 	//    description: add checkpoint statistics to the returned CheckpointReport
+	//  - in: query
+	//    name: createImage
+	//    type: string
+	//    description: commit the checkpoint as an OCI image with this repo:tag
 	// produces:
 	// - application/json
 	// responses:
@@ -1518,6 +1526,10 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    name: printStats
 	//    type: boolean
 	//    description: add restore statistics to the returned RestoreReport
+	//  - in: query
+	//    name: importImage
+	//    type: string
+	//    description: restore from a checkpoint image previously created with createImage, pulling it if needed
 	// produces:
 	// - application/json
 	// responses:
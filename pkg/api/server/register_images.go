@@ -840,6 +840,16 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//        - `id`=(`<image-id>`)
 	//        - `since`=(`<image-name>[:<tag>]`,  `<image id>` or `<image@digest>`)
 	//     type: string
+	//   - name: limit
+	//     in: query
+	//     description: Restrict the number of images returned, newest first. 0 means no limit.
+	//     type: integer
+	//     default: 0
+	//   - name: offset
+	//     in: query
+	//     description: Skip the first n newest images before limit is applied.
+	//     type: integer
+	//     default: 0
 	// produces:
 	// - application/json
 	// responses:
@@ -386,6 +386,33 @@ func (s *APIServer) registerNetworkHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.HandleFunc(VersionedPath("/libpod/networks/{name}/disconnect"), s.APIHandler(compat.Disconnect)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/networks/{name}/update libpod NetworkUpdateLibpod
+	// ---
+	// tags:
+	//  - networks
+	// summary: Update a network
+	// description: Update network-scoped DNS aliases for a container already connected to a network.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the network
+	//  - in: body
+	//    name: create
+	//    description: attributes for updating a network's aliases for a container
+	//    schema:
+	//      $ref: "#/definitions/NetworkUpdateRequest"
+	// responses:
+	//   200:
+	//     description: OK
+	//   404:
+	//     $ref: "#/responses/NoSuchNetwork"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/libpod/networks/{name}/update"), s.APIHandler(libpod.Update)).Methods(http.MethodPost)
 	// swagger:operation POST /libpod/networks/prune libpod NetworkPruneLibpod
 	// ---
 	// tags:
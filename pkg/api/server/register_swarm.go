@@ -5,11 +5,43 @@ import (
 	"net/http"
 
 	"github.com/containers/podman/v4/pkg/api/handlers/utils"
+	"github.com/docker/docker/api/types/swarm"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
 )
 
 func (s *APIServer) registerSwarmHandlers(r *mux.Router) error {
+	// swagger:operation GET /swarm compat SwarmInspect
+	// ---
+	// tags:
+	//  - swarm (compat)
+	// summary: Inspect swarm
+	// description: Returns a stub, inactive swarm object since podman does
+	//   not implement swarm mode. This lets tooling that merely probes for
+	//   swarm state get a sensible JSON response instead of a 404.
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     description: no error
+	r.Handle(VersionedPath("/swarm"), s.APIHandler(swarmInspect)).Methods(http.MethodGet)
+	r.Handle("/swarm", s.APIHandler(swarmInspect)).Methods(http.MethodGet)
+	// swagger:operation GET /nodes compat NodeList
+	// ---
+	// tags:
+	//  - swarm (compat)
+	// summary: List nodes
+	// description: Returns an empty list since podman does not implement
+	//   swarm mode. This lets tooling that merely enumerates swarm nodes
+	//   get a sensible JSON response instead of a 404.
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     description: no error
+	r.Handle(VersionedPath("/nodes"), s.APIHandler(nodeList)).Methods(http.MethodGet)
+	r.Handle("/nodes", s.APIHandler(nodeList)).Methods(http.MethodGet)
+
 	r.PathPrefix("/v{version:[0-9.]+}/configs/").HandlerFunc(noSwarm)
 	r.PathPrefix("/v{version:[0-9.]+}/nodes/").HandlerFunc(noSwarm)
 	r.PathPrefix("/v{version:[0-9.]+}/secrets/").HandlerFunc(noSwarm)
@@ -27,6 +59,20 @@ func (s *APIServer) registerSwarmHandlers(r *mux.Router) error {
 	return nil
 }
 
+// swarmInspect returns a stub, inactive Swarm object. Podman does not
+// implement swarm mode, but tooling built against the Docker SDK often
+// probes this endpoint just to check whether swarm is active; a sensible
+// stub response avoids breaking that tooling with a 404.
+func swarmInspect(w http.ResponseWriter, r *http.Request) {
+	utils.WriteResponse(w, http.StatusOK, swarm.Swarm{})
+}
+
+// nodeList returns an empty node list, since a non-swarm podman engine has
+// no swarm nodes to report.
+func nodeList(w http.ResponseWriter, r *http.Request) {
+	utils.WriteResponse(w, http.StatusOK, []swarm.Node{})
+}
+
 // noSwarm returns http.StatusServiceUnavailable rather than something like http.StatusInternalServerError,
 // this allows the client to decide if they still can talk to us
 func noSwarm(w http.ResponseWriter, r *http.Request) {
@@ -69,6 +69,14 @@ func (s *APIServer) registerVolumeHandlers(r *mux.Router) error {
 	//        - name=<volume-name> Matches all of volume name.
 	//        - opt=<driver-option> Matches a storage driver options
 	//        - `until=<timestamp>` List volumes created before this timestamp. The `<timestamp>` can be Unix timestamps, date formatted timestamps, or Go duration strings (e.g. `10m`, `1h30m`) computed relative to the daemon machine’s time.
+	//  - in: query
+	//    name: limit
+	//    type: integer
+	//    description: Restrict the number of volumes returned, newest first. 0 means no limit.
+	//  - in: query
+	//    name: offset
+	//    type: integer
+	//    description: Skip the first n newest volumes before limit is applied.
 	// responses:
 	//   '200':
 	//     "$ref": "#/responses/VolumeList"
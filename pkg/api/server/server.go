@@ -2,6 +2,8 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"log"
 	"net"
@@ -15,6 +17,7 @@ import (
 	"time"
 
 	"github.com/containers/podman/v4/libpod"
+	"github.com/containers/podman/v4/libpod/events"
 	"github.com/containers/podman/v4/libpod/shutdown"
 	"github.com/containers/podman/v4/pkg/api/handlers"
 	"github.com/containers/podman/v4/pkg/api/server/idle"
@@ -28,15 +31,16 @@ import (
 )
 
 type APIServer struct {
-	http.Server                      // The  HTTP work happens here
-	net.Listener                     // mux for routing HTTP API calls to libpod routines
-	*libpod.Runtime                  // Where the real work happens
-	*schema.Decoder                  // Decoder for Query parameters to structs
-	context.CancelFunc               // Stop APIServer
-	context.Context                  // Context to carry objects to handlers
-	CorsHeaders        string        // Inject Cross-Origin Resource Sharing (CORS) headers
-	PProfAddr          string        // Binding network address for pprof profiles
-	idleTracker        *idle.Tracker // Track connections to support idle shutdown
+	http.Server                            // The  HTTP work happens here
+	net.Listener                           // mux for routing HTTP API calls to libpod routines
+	*libpod.Runtime                        // Where the real work happens
+	*schema.Decoder                        // Decoder for Query parameters to structs
+	context.CancelFunc                     // Stop APIServer
+	context.Context                        // Context to carry objects to handlers
+	CorsHeaders              string        // Inject Cross-Origin Resource Sharing (CORS) headers
+	PProfAddr                string        // Binding network address for pprof profiles
+	idleTracker              *idle.Tracker // Track connections to support idle shutdown
+	stopContainersOnShutdown bool          // Stop running containers, in reverse dependency order, on shutdown
 }
 
 // Number of seconds to wait for next request, if exceeded shutdown server
@@ -80,6 +84,13 @@ func newServer(runtime *libpod.Runtime, listener *net.Listener, opts entities.Se
 		}
 		listener = &listeners[0]
 	}
+	if opts.TLSCert != "" || opts.TLSKey != "" {
+		wrapped, err := wrapTLSListener(*listener, opts, runtime)
+		if err != nil {
+			return nil, fmt.Errorf("configuring TLS for API service: %w", err)
+		}
+		listener = &wrapped
+	}
 	if opts.CorsHeaders == "" {
 		logrus.Debug("CORS Headers were not set")
 	} else {
@@ -100,10 +111,12 @@ func newServer(runtime *libpod.Runtime, listener *net.Listener, opts entities.Se
 			Handler:     router,
 			IdleTimeout: opts.Timeout * 2,
 		},
-		CorsHeaders: opts.CorsHeaders,
-		Listener:    *listener,
-		PProfAddr:   opts.PProfAddr,
-		idleTracker: tracker,
+		Runtime:                  runtime,
+		CorsHeaders:              opts.CorsHeaders,
+		Listener:                 *listener,
+		PProfAddr:                opts.PProfAddr,
+		idleTracker:              tracker,
+		stopContainersOnShutdown: opts.StopContainersOnShutdown,
 	}
 
 	server.BaseContext = func(l net.Listener) context.Context {
@@ -116,6 +129,18 @@ func newServer(runtime *libpod.Runtime, listener *net.Listener, opts entities.Se
 	// Capture panics and print stack traces for diagnostics,
 	// additionally process X-Reference-Id Header to support event correlation
 	router.Use(panicHandler(), referenceIDHandler())
+	if opts.RateLimit > 0 {
+		router.Use(rateLimitHandler(opts.RateLimit))
+	}
+	if opts.IdempotencyWindow > 0 {
+		router.Use(idempotencyHandler(opts.IdempotencyWindow))
+	}
+	if opts.AuditLogPath != "" {
+		router.Use(auditLogHandler(opts.AuditLogPath))
+	}
+	if opts.AuthzPlugin != "" || opts.AuthzPolicyFile != "" {
+		router.Use(authorizationHandler(opts))
+	}
 	router.NotFoundHandler = http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
 			// We can track user errors...
@@ -135,6 +160,7 @@ func newServer(runtime *libpod.Runtime, listener *net.Listener, opts entities.Se
 	for _, fn := range []func(*mux.Router) error{
 		server.registerAuthHandlers,
 		server.registerArchiveHandlers,
+		server.registerConfigsHandlers,
 		server.registerContainersHandlers,
 		server.registerDistributionHandlers,
 		server.registerEventsHandlers,
@@ -182,6 +208,46 @@ func newServer(runtime *libpod.Runtime, listener *net.Listener, opts entities.Se
 	return &server, nil
 }
 
+// wrapTLSListener wraps l so that it terminates TLS, using the certificate
+// and key given by opts.TLSCert/opts.TLSKey. When opts.TLSCACert is set,
+// client certificates are required and verified against that CA; on a
+// successful handshake a ClientAuth event is recorded against runtime,
+// naming the verified client certificate's subject.
+func wrapTLSListener(l net.Listener, opts entities.ServiceOptions, runtime *libpod.Runtime) (net.Listener, error) {
+	cert, err := tls.LoadX509KeyPair(opts.TLSCert, opts.TLSKey)
+	if err != nil {
+		return nil, fmt.Errorf("loading TLS certificate/key pair: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if opts.TLSCACert != "" {
+		caCert, err := os.ReadFile(opts.TLSCACert)
+		if err != nil {
+			return nil, fmt.Errorf("reading TLS CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates found in %q", opts.TLSCACert)
+		}
+
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		tlsConfig.VerifyPeerCertificate = func(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+			if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
+				return nil
+			}
+			runtime.NewSystemEvent(events.ClientAuth, verifiedChains[0][0].Subject.CommonName)
+			return nil
+		}
+	}
+
+	return tls.NewListener(l, tlsConfig), nil
+}
+
 // setupSystemd notifies systemd API service is ready
 // If the NOTIFY_SOCKET is set, communicate the PID and readiness, and unset INVOCATION_ID
 // so conmon and containers are in the correct cgroup.
@@ -299,10 +365,31 @@ func (s *APIServer) Shutdown(halt bool) error {
 			}
 		}()
 		<-ctx.Done()
+
+		if halt && s.stopContainersOnShutdown {
+			s.stopManagedContainers()
+		}
 	})
 	return nil
 }
 
+// stopManagedContainers stops the runtime's running containers, in reverse
+// dependency order, and logs a summary of the result. It is called when the
+// API service is shutting down, so that dependent containers are not left
+// running as conmon orphans once the service that was managing them exits.
+func (s *APIServer) stopManagedContainers() {
+	report, err := s.Runtime.StopRunningContainers(context.Background())
+	if err != nil {
+		logrus.Errorf("Stopping managed containers on service shutdown: %v", err)
+		return
+	}
+
+	logrus.Infof("Stopped %d container(s) on service shutdown", len(report.Stopped))
+	for _, failure := range report.Errors {
+		logrus.Warnf("Failed to stop container %s on service shutdown: %s", failure.ID, failure.Error)
+	}
+}
+
 // Close immediately stops responding to clients and exits
 func (s *APIServer) Close() error {
 	return s.Server.Close()
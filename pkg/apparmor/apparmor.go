@@ -0,0 +1,7 @@
+package apparmor
+
+import "github.com/pkg/errors"
+
+// ErrApparmorFile indicates that the given file did not contain a valid
+// AppArmor profile.
+var ErrApparmorFile = errors.New("invalid AppArmor profile file")
@@ -0,0 +1,97 @@
+//go:build linux
+// +build linux
+
+package apparmor
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/containers/common/pkg/apparmor"
+	"github.com/containers/storage/pkg/unshare"
+	"github.com/pkg/errors"
+)
+
+// LoadProfileFromFile loads the AppArmor profile defined in the file at
+// path into the kernel via apparmor_parser, and returns the name the
+// profile declares (e.g. "profile my-profile {" -> "my-profile"), so that
+// it can be referenced the same way a preloaded profile would be.
+func LoadProfileFromFile(path string) (string, error) {
+	if unshare.IsRootless() {
+		return "", apparmor.ErrApparmorRootless
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "reading AppArmor profile %q", path)
+	}
+
+	name, err := parseProfileName(content)
+	if err != nil {
+		return "", err
+	}
+
+	parserPath, err := exec.LookPath("apparmor_parser")
+	if err != nil {
+		return "", errors.Wrap(err, "find `apparmor_parser` binary")
+	}
+
+	cmd := exec.Command(parserPath, "-Kr", path)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return "", errors.Wrapf(err, "load AppArmor profile %q: %s", path, string(out))
+	}
+
+	return name, nil
+}
+
+// UnloadProfile removes the named profile from the kernel.
+func UnloadProfile(name string) error {
+	if unshare.IsRootless() {
+		return apparmor.ErrApparmorRootless
+	}
+
+	f, err := os.OpenFile("/sys/kernel/security/apparmor/.remove", os.O_WRONLY, 0)
+	if err != nil {
+		return errors.Wrapf(err, "open AppArmor remove interface to unload %q", name)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(name); err != nil {
+		return errors.Wrapf(err, "unload AppArmor profile %q", name)
+	}
+	return nil
+}
+
+// parseProfileName extracts the profile name declared by an AppArmor
+// profile file, e.g. "profile my-profile flags=(complain) {" -> "my-profile".
+func parseProfileName(content []byte) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !strings.Contains(line, "{") {
+			continue
+		}
+		line = strings.TrimSpace(strings.SplitN(line, "{", 2)[0])
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			break
+		}
+		if fields[0] == "profile" {
+			fields = fields[1:]
+		}
+		if len(fields) == 0 {
+			break
+		}
+		return strings.Trim(fields[0], `"`), nil
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", ErrApparmorFile
+}
@@ -0,0 +1,16 @@
+//go:build !linux
+// +build !linux
+
+package apparmor
+
+import "github.com/containers/common/pkg/apparmor"
+
+// LoadProfileFromFile is not supported outside Linux.
+func LoadProfileFromFile(path string) (string, error) {
+	return "", apparmor.ErrApparmorUnsupported
+}
+
+// UnloadProfile is not supported outside Linux.
+func UnloadProfile(name string) error {
+	return apparmor.ErrApparmorUnsupported
+}
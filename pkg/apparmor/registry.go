@@ -0,0 +1,99 @@
+package apparmor
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/storage/pkg/lockfile"
+	"github.com/pkg/errors"
+)
+
+// registryFileName is the name of the file, relative to the engine's
+// tmpdir, that tracks AppArmor profiles Podman itself loaded from a file
+// (as opposed to profiles that were already present on the host), so that
+// they can be unloaded again once no container references them.
+const registryFileName = "apparmor-loaded-profiles.json"
+
+// Registry tracks the AppArmor profiles Podman loaded from a file via
+// `--security-opt apparmor=<path>`, mapped to the file they were loaded
+// from.
+type Registry struct {
+	path string
+	lock lockfile.Locker
+}
+
+// NewRegistry returns a Registry backed by a JSON file under tmpDir.
+func NewRegistry(tmpDir string) (*Registry, error) {
+	path := filepath.Join(tmpDir, registryFileName)
+	lock, err := lockfile.GetLockfile(path + ".lock")
+	if err != nil {
+		return nil, errors.Wrap(err, "obtaining AppArmor profile registry lock")
+	}
+	return &Registry{path: path, lock: lock}, nil
+}
+
+// Add records that Podman loaded the named profile from sourcePath.
+func (r *Registry) Add(name, sourcePath string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	profiles, err := r.readLocked()
+	if err != nil {
+		return err
+	}
+	profiles[name] = sourcePath
+	return r.writeLocked(profiles)
+}
+
+// Remove drops the named profile from the registry, if present.
+func (r *Registry) Remove(name string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	profiles, err := r.readLocked()
+	if err != nil {
+		return err
+	}
+	if _, ok := profiles[name]; !ok {
+		return nil
+	}
+	delete(profiles, name)
+	return r.writeLocked(profiles)
+}
+
+// Profiles returns the profiles Podman has loaded from a file, mapped to
+// the file they were loaded from.
+func (r *Registry) Profiles() (map[string]string, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.readLocked()
+}
+
+func (r *Registry) readLocked() (map[string]string, error) {
+	profiles := make(map[string]string)
+	b, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return profiles, nil
+		}
+		return nil, errors.Wrap(err, "reading AppArmor profile registry")
+	}
+	if len(b) == 0 {
+		return profiles, nil
+	}
+	if err := json.Unmarshal(b, &profiles); err != nil {
+		return nil, errors.Wrap(err, "parsing AppArmor profile registry")
+	}
+	return profiles, nil
+}
+
+func (r *Registry) writeLocked(profiles map[string]string) error {
+	b, err := json.Marshal(profiles)
+	if err != nil {
+		return errors.Wrap(err, "marshalling AppArmor profile registry")
+	}
+	return errors.Wrap(ioutil.WriteFile(r.path, b, 0644), "writing AppArmor profile registry")
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/blang/semver"
+	"github.com/containers/common/pkg/config"
 	"github.com/containers/podman/v4/pkg/terminal"
 	"github.com/containers/podman/v4/version"
 	"github.com/pkg/errors"
@@ -64,6 +65,24 @@ func NewConnection(ctx context.Context, uri string) (context.Context, error) {
 	return NewConnectionWithIdentity(ctx, uri, "")
 }
 
+// NewConnectionByName looks up name in the user's configured
+// `podman system connection` destinations and returns a context connected to
+// it, the same as NewConnectionWithIdentity would for its URI and identity.
+// Since each call returns an independent context, callers can hold several
+// named connections concurrently in one process, e.g. to fan an operation
+// out to multiple hosts.
+func NewConnectionByName(ctx context.Context, name string) (context.Context, error) {
+	cfg, err := config.Default()
+	if err != nil {
+		return nil, err
+	}
+	dst, found := cfg.Engine.ServiceDestinations[name]
+	if !found {
+		return nil, errors.Errorf("connection %q not found", name)
+	}
+	return NewConnectionWithIdentity(ctx, dst.URI, dst.Identity)
+}
+
 // NewConnectionWithIdentity takes a URI as a string and returns a context with the
 // Connection embedded as a value.  This context needs to be passed to each
 // endpoint to work correctly.
@@ -58,6 +58,14 @@ func CopyFromArchive(ctx context.Context, nameOrID string, path string, reader i
 //
 // FIXME: remove this function and make CopyFromArchive accept the option as the last parameter in podman 4.0
 func CopyFromArchiveWithOptions(ctx context.Context, nameOrID string, path string, reader io.Reader, options *CopyOptions) (entities.ContainerCopyFunc, error) {
+	return CopyFromArchiveWithOptionsAndProgress(ctx, nameOrID, path, reader, options, nil)
+}
+
+// CopyFromArchiveWithOptionsAndProgress copies files into a container like
+// CopyFromArchiveWithOptions, additionally invoking progress for every chunk
+// of the tar stream that has been written, with the cumulative byte count
+// written so far. progress may be nil.
+func CopyFromArchiveWithOptionsAndProgress(ctx context.Context, nameOrID string, path string, reader io.Reader, options *CopyOptions, progress func(written int64)) (entities.ContainerCopyFunc, error) {
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return nil, err
@@ -70,6 +78,10 @@ func CopyFromArchiveWithOptions(ctx context.Context, nameOrID string, path strin
 
 	params.Set("path", path)
 
+	if progress != nil {
+		reader = &progressReader{reader: reader, progress: progress}
+	}
+
 	return func() error {
 		response, err := conn.DoRequest(ctx, reader, http.MethodPut, "/containers/%s/archive", params, nil, nameOrID)
 		if err != nil {
@@ -85,6 +97,14 @@ func CopyFromArchiveWithOptions(ctx context.Context, nameOrID string, path strin
 
 // CopyToArchive copy files from container
 func CopyToArchive(ctx context.Context, nameOrID string, path string, writer io.Writer) (entities.ContainerCopyFunc, error) {
+	return CopyToArchiveWithProgress(ctx, nameOrID, path, writer, nil)
+}
+
+// CopyToArchiveWithProgress copies files out of a container like
+// CopyToArchive, additionally invoking progress for every chunk of the tar
+// stream that has been read, with the cumulative byte count read so far.
+// progress may be nil.
+func CopyToArchiveWithProgress(ctx context.Context, nameOrID string, path string, writer io.Writer, progress func(written int64)) (entities.ContainerCopyFunc, error) {
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return nil, err
@@ -102,9 +122,43 @@ func CopyToArchive(ctx context.Context, nameOrID string, path string, writer io.
 		return nil, response.Process(nil)
 	}
 
+	if progress != nil {
+		writer = &progressWriter{writer: writer, progress: progress}
+	}
+
 	return func() error {
 		defer response.Body.Close()
 		_, err := io.Copy(writer, response.Body)
 		return err
 	}, nil
 }
+
+// progressReader wraps an io.Reader, reporting the cumulative number of
+// bytes read after every Read call.
+type progressReader struct {
+	reader   io.Reader
+	progress func(written int64)
+	written  int64
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.reader.Read(b)
+	p.written += int64(n)
+	p.progress(p.written)
+	return n, err
+}
+
+// progressWriter wraps an io.Writer, reporting the cumulative number of
+// bytes written after every Write call.
+type progressWriter struct {
+	writer   io.Writer
+	progress func(written int64)
+	written  int64
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.writer.Write(b)
+	p.written += int64(n)
+	p.progress(p.written)
+	return n, err
+}
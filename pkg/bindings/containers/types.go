@@ -18,6 +18,8 @@ type LogOptions struct {
 	Tail       *string
 	Timestamps *bool
 	Until      *string
+	Grep       *string
+	GrepInvert *bool
 }
 
 //go:generate go run ../generator/generator.go CommitOptions
@@ -55,6 +57,7 @@ type CheckpointOptions struct {
 	PreCheckpoint  *bool
 	WithPrevious   *bool
 	FileLocks      *bool
+	CreateImage    *string
 }
 
 //go:generate go run ../generator/generator.go RestoreOptions
@@ -79,6 +82,9 @@ type RestoreOptions struct {
 	PrintStats     *bool
 	PublishPorts   []string
 	FileLocks      *bool
+	// ImportImage is the reference of a checkpoint image previously
+	// created with CheckpointOptions.CreateImage.
+	ImportImage *string
 }
 
 //go:generate go run ../generator/generator.go CreateOptions
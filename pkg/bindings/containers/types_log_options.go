@@ -121,3 +121,33 @@ func (o *LogOptions) GetUntil() string {
 	}
 	return *o.Until
 }
+
+// WithGrep set field Grep to given value
+func (o *LogOptions) WithGrep(value string) *LogOptions {
+	o.Grep = &value
+	return o
+}
+
+// GetGrep returns value of field Grep
+func (o *LogOptions) GetGrep() string {
+	if o.Grep == nil {
+		var z string
+		return z
+	}
+	return *o.Grep
+}
+
+// WithGrepInvert set field GrepInvert to given value
+func (o *LogOptions) WithGrepInvert(value bool) *LogOptions {
+	o.GrepInvert = &value
+	return o
+}
+
+// GetGrepInvert returns value of field GrepInvert
+func (o *LogOptions) GetGrepInvert() bool {
+	if o.GrepInvert == nil {
+		var z bool
+		return z
+	}
+	return *o.GrepInvert
+}
@@ -211,3 +211,18 @@ func (o *RestoreOptions) GetFileLocks() bool {
 	}
 	return *o.FileLocks
 }
+
+// WithImportImage set field ImportImage to given value
+func (o *RestoreOptions) WithImportImage(value string) *RestoreOptions {
+	o.ImportImage = &value
+	return o
+}
+
+// GetImportImage returns value of field ImportImage
+func (o *RestoreOptions) GetImportImage() string {
+	if o.ImportImage == nil {
+		var z string
+		return z
+	}
+	return *o.ImportImage
+}
@@ -31,6 +31,10 @@ type ListOptions struct {
 	All *bool
 	// filters that can be used to get a more specific list of images
 	Filters map[string][]string
+	// Limit restricts the number of images returned, newest first
+	Limit *int
+	// Offset skips over the first n newest images before Limit is applied
+	Offset *int
 }
 
 //go:generate go run ../generator/generator.go GetOptions
@@ -46,3 +46,33 @@ func (o *ListOptions) GetFilters() map[string][]string {
 	}
 	return o.Filters
 }
+
+// WithLimit set field Limit to given value
+func (o *ListOptions) WithLimit(value int) *ListOptions {
+	o.Limit = &value
+	return o
+}
+
+// GetLimit returns value of field Limit
+func (o *ListOptions) GetLimit() int {
+	if o.Limit == nil {
+		var z int
+		return z
+	}
+	return *o.Limit
+}
+
+// WithOffset set field Offset to given value
+func (o *ListOptions) WithOffset(value int) *ListOptions {
+	o.Offset = &value
+	return o
+}
+
+// GetOffset returns value of field Offset
+func (o *ListOptions) GetOffset() int {
+	if o.Offset == nil {
+		var z int
+		return z
+	}
+	return *o.Offset
+}
@@ -163,6 +163,28 @@ func Connect(ctx context.Context, networkName string, containerNameOrID string,
 	return response.Process(nil)
 }
 
+// Update adds and/or removes network-scoped DNS aliases for a container
+// already connected to the given network.
+func Update(ctx context.Context, networkName string, options entities.NetworkUpdateOptions) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := jsoniter.MarshalToString(options)
+	if err != nil {
+		return err
+	}
+	stringReader := strings.NewReader(body)
+	response, err := conn.DoRequest(ctx, stringReader, http.MethodPost, "/networks/%s/update", nil, nil, networkName)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return response.Process(nil)
+}
+
 // Exists returns true if a given network exists
 func Exists(ctx context.Context, nameOrID string, options *ExistsOptions) (bool, error) {
 	conn, err := bindings.GetClient(ctx)
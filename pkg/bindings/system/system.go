@@ -60,6 +60,50 @@ func Events(ctx context.Context, eventChan chan entities.Event, cancelChan chan
 	}
 }
 
+// EventsWebSocket is identical to Events except that it consumes the
+// events endpoint's WebSocket variant rather than its chunked-transfer one,
+// letting callers that already speak WebSocket (e.g. browser dashboards
+// proxied through this binding) avoid holding a plain streaming HTTP
+// connection open.
+func EventsWebSocket(ctx context.Context, eventChan chan entities.Event, cancelChan chan bool, options *EventsOptions) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return err
+	}
+	socket, br, err := bindings.DialWebSocket(ctx, conn, "/events", params)
+	if err != nil {
+		return err
+	}
+	defer socket.Close()
+
+	if cancelChan != nil {
+		go func() {
+			<-cancelChan
+			logrus.Error(errors.Wrap(socket.Close(), "unable to close event websocket"))
+		}()
+	}
+
+	defer close(eventChan)
+	for {
+		payload, err := bindings.ReadWebSocketMessage(br)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.Wrap(err, "unable to read event websocket message")
+		}
+		var e entities.Event
+		if err := json.Unmarshal(payload, &e); err != nil {
+			return errors.Wrap(err, "unable to decode event response")
+		}
+		eventChan <- e
+	}
+}
+
 // Prune removes all unused system data.
 func Prune(ctx context.Context, options *PruneOptions) (*entities.SystemPruneReport, error) {
 	var (
@@ -15,6 +15,10 @@ type InspectOptions struct {
 type ListOptions struct {
 	// Filters applied to the listing of volumes
 	Filters map[string][]string
+	// Limit restricts the number of volumes returned, newest first
+	Limit *int
+	// Offset skips over the first n newest volumes before Limit is applied
+	Offset *int
 }
 
 //go:generate go run ../generator/generator.go PruneOptions
@@ -0,0 +1,114 @@
+package bindings
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// DialWebSocket performs the WebSocket opening handshake (RFC 6455) against
+// endpoint and returns the raw, hijacked connection for reading frames sent
+// by the server. It mirrors the DialContext-capture trick containers.Attach
+// uses to obtain the raw socket behind an "Upgrade" request, since
+// Connection.Client offers no other way to reach past its *http.Client.
+func DialWebSocket(ctx context.Context, conn *Connection, endpoint string, params url.Values) (net.Conn, *bufio.Reader, error) {
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+	headers := make(http.Header)
+	headers.Add("Connection", "Upgrade")
+	headers.Add("Upgrade", "websocket")
+	headers.Add("Sec-WebSocket-Version", "13")
+	headers.Add("Sec-WebSocket-Key", base64.StdEncoding.EncodeToString(key))
+
+	var socket net.Conn
+	socketSet := false
+	dialContext := conn.Client.Transport.(*http.Transport).DialContext
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				c, err := dialContext(ctx, network, address)
+				if err != nil {
+					return nil, err
+				}
+				if !socketSet {
+					socket = c
+					socketSet = true
+				}
+				return c, err
+			},
+		},
+	}
+	upgraded := &Connection{URI: conn.URI, Client: client}
+
+	response, err := upgraded.DoRequest(ctx, nil, http.MethodGet, endpoint, params, headers)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		return nil, nil, errors.Errorf("unable to upgrade connection: server responded with status %d", response.StatusCode)
+	}
+	if socket == nil {
+		return nil, nil, errors.New("unable to obtain raw connection for websocket upgrade")
+	}
+
+	return socket, bufio.NewReader(socket), nil
+}
+
+// ReadWebSocketMessage reads a single WebSocket message from br, returning
+// its payload. Only text and binary frames carry data of interest to a
+// streaming API client; a close frame is reported as io.EOF.
+func ReadWebSocketMessage(br *bufio.Reader) ([]byte, error) {
+	header, err := readN(br, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	opcode := header[0] & 0x0f
+	length := int64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		ext, err := readN(br, 2)
+		if err != nil {
+			return nil, err
+		}
+		length = int64(binary.BigEndian.Uint16(ext))
+	case 127:
+		ext, err := readN(br, 8)
+		if err != nil {
+			return nil, err
+		}
+		length = int64(binary.BigEndian.Uint64(ext))
+	}
+
+	// Server-to-client frames are never masked (RFC 6455 section 5.1).
+	payload, err := readN(br, int(length))
+	if err != nil {
+		return nil, err
+	}
+
+	if opcode == 0x8 {
+		return nil, io.EOF
+	}
+	return payload, nil
+}
+
+func readN(r io.Reader, n int) ([]byte, error) {
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, fmt.Errorf("reading websocket frame: %w", err)
+	}
+	return buf, nil
+}
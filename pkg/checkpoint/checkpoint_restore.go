@@ -4,6 +4,7 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"sort"
 
 	metadata "github.com/checkpoint-restore/checkpointctl/lib"
 	"github.com/containers/common/libimage"
@@ -239,3 +240,62 @@ func CRImportCheckpoint(ctx context.Context, runtime *libpod.Runtime, restoreOpt
 	containers = append(containers, container)
 	return containers, nil
 }
+
+// CRRestorePrecheck validates that the image, networks and named volumes
+// required by the checkpoint at restoreOptions.Import are in a state that
+// allows the checkpoint to be restored, without touching the container.
+func CRRestorePrecheck(runtime *libpod.Runtime, restoreOptions entities.RestoreOptions) (*entities.RestorePrecheckReport, error) {
+	dir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("Could not recursively remove %s: %q", dir, err)
+		}
+	}()
+	if err := crutils.CRImportCheckpointConfigOnly(dir, restoreOptions.Import); err != nil {
+		return nil, err
+	}
+
+	ctrConfig := new(libpod.ContainerConfig)
+	if _, err := metadata.ReadJSONFile(ctrConfig, dir, metadata.ConfigDumpFile); err != nil {
+		return nil, err
+	}
+
+	report := new(entities.RestorePrecheckReport)
+
+	if ctrConfig.RootfsImageID != "" {
+		if _, _, err := runtime.LibimageRuntime().LookupImage(ctrConfig.RootfsImageID, nil); err != nil {
+			report.MissingImage = ctrConfig.RootfsImageName
+			if report.MissingImage == "" {
+				report.MissingImage = ctrConfig.RootfsImageID
+			}
+		}
+	}
+
+	for name := range ctrConfig.Networks {
+		if _, err := runtime.Network().NetworkInspect(name); err != nil {
+			report.MissingNetworks = append(report.MissingNetworks, name)
+		}
+	}
+
+	for _, vol := range ctrConfig.NamedVolumes {
+		exists, err := runtime.HasVolume(vol.Name)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case restoreOptions.IgnoreVolumes && !exists:
+			report.MissingVolumes = append(report.MissingVolumes, vol.Name)
+		case !restoreOptions.IgnoreVolumes && exists:
+			report.ConflictingVolumes = append(report.ConflictingVolumes, vol.Name)
+		}
+	}
+
+	sort.Strings(report.MissingNetworks)
+	sort.Strings(report.MissingVolumes)
+	sort.Strings(report.ConflictingVolumes)
+
+	return report, nil
+}
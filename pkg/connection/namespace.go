@@ -0,0 +1,91 @@
+package connection
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v4/pkg/rootless"
+	"github.com/containers/podman/v4/pkg/util"
+)
+
+// namespacesFileName is a small podman-owned JSON file recording the default
+// libpod namespace configured for each `podman system connection`. This data
+// cannot live on config.Destination itself: that struct is defined in the
+// vendored containers/common module, and podman cannot add fields to a
+// dependency's types without a real upstream vendor bump.
+const namespacesFileName = "podman-connections.json"
+
+func namespacesFilePath() (string, error) {
+	if !rootless.IsRootless() {
+		return filepath.Join(filepath.Dir(config.OverrideContainersConfig), namespacesFileName), nil
+	}
+	dir, err := util.GetRootlessConfigHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "containers", namespacesFileName), nil
+}
+
+func readNamespaces() (map[string]string, error) {
+	path, err := namespacesFilePath()
+	if err != nil {
+		return nil, err
+	}
+	namespaces := make(map[string]string)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return namespaces, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &namespaces); err != nil {
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+// Namespace returns the default libpod namespace recorded for the named
+// connection, or "" if the connection has none set.
+func Namespace(name string) (string, error) {
+	namespaces, err := readNamespaces()
+	if err != nil {
+		return "", err
+	}
+	return namespaces[name], nil
+}
+
+// SetNamespace records the default libpod namespace for the named
+// connection, or clears it if namespace is empty.
+func SetNamespace(name, namespace string) error {
+	namespaces, err := readNamespaces()
+	if err != nil {
+		return err
+	}
+	if namespace == "" {
+		delete(namespaces, name)
+	} else {
+		namespaces[name] = namespace
+	}
+	path, err := namespacesFilePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(namespaces, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// RemoveNamespace forgets the recorded default namespace for the named
+// connection, if any. Safe to call for a connection with no namespace set.
+func RemoveNamespace(name string) error {
+	return SetNamespace(name, "")
+}
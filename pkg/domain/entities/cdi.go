@@ -0,0 +1,25 @@
+package entities
+
+// CDIDevice describes a single device made available through a Container
+// Device Interface (CDI) spec file, resolvable with `--device
+// <vendor>/<class>=<name>`.
+type CDIDevice struct {
+	// QualifiedName is the full <vendor>/<class>=<name> device reference.
+	QualifiedName string
+	// Vendor is the device vendor, e.g. "nvidia.com".
+	Vendor string
+	// Class is the device class, e.g. "gpu".
+	Class string
+	// SpecFile is the path to the CDI spec file the device was found in.
+	SpecFile string
+}
+
+// CDIInspectReport describes a single CDI device in detail, including the
+// container edits (device nodes, environment, hooks and mounts) that
+// resolving it injects into a container.
+type CDIInspectReport struct {
+	CDIDevice
+	// ContainerEdits is the raw container-edits section of the device's
+	// CDI spec, as JSON.
+	ContainerEdits []byte
+}
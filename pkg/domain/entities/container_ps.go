@@ -63,6 +63,10 @@ type ListContainer struct {
 	State string
 	// Status is a human-readable approximation of a duration for json output
 	Status string
+	// Server is the name of the connection this container was listed from.
+	// Only set when the result was produced by a multi-connection fan-out,
+	// e.g. `podman --connections a,b ps`.
+	Server string `json:",omitempty"`
 }
 
 // ListContainerNamespaces contains the identifiers of the container's Linux namespaces
@@ -80,6 +80,45 @@ type PauseUnpauseReport struct {
 	Id  string //nolint
 }
 
+// ContainerUpdateOptions describes the resource limit changes to apply to a
+// running container.
+type ContainerUpdateOptions struct {
+	// DeviceCgroupRule is a set of device cgroup rules, in the same
+	// "type major:minor access" format accepted by --device-cgroup-rule
+	// at container creation, to add to the container's effective rule
+	// set.
+	DeviceCgroupRule []string
+	// BlkIOWeight sets the relative weight of the container's block I/O,
+	// in the same format accepted by --blkio-weight at container
+	// creation.
+	BlkIOWeight string
+	// BlkIOWeightDevice sets a relative I/O weight for individual
+	// devices, in the same "device:weight" format accepted by
+	// --blkio-weight-device at container creation.
+	BlkIOWeightDevice []string
+	// DeviceReadBPs limits read rate (bytes per second) from individual
+	// devices, in the same "device:rate" format accepted by
+	// --device-read-bps at container creation.
+	DeviceReadBPs []string
+	// DeviceWriteBPs limits write rate (bytes per second) to individual
+	// devices, in the same "device:rate" format accepted by
+	// --device-write-bps at container creation.
+	DeviceWriteBPs []string
+	// DeviceReadIOPs limits read rate (IO per second) from individual
+	// devices, in the same "device:rate" format accepted by
+	// --device-read-iops at container creation.
+	DeviceReadIOPs []string
+	// DeviceWriteIOPs limits write rate (IO per second) to individual
+	// devices, in the same "device:rate" format accepted by
+	// --device-write-iops at container creation.
+	DeviceWriteIOPs []string
+}
+
+type ContainerUpdateReport struct {
+	Err error
+	Id  string //nolint
+}
+
 type StopOptions struct {
 	All     bool
 	Ignore  bool
@@ -189,6 +228,10 @@ type CheckpointOptions struct {
 	Compression    archive.Compression
 	PrintStats     bool
 	FileLocks      bool
+	// CreateImage, if set, additionally commits the checkpoint as an OCI
+	// image with this repo:tag so it can be pushed and restored on
+	// another host.
+	CreateImage string
 }
 
 type CheckpointReport struct {
@@ -196,6 +239,9 @@ type CheckpointReport struct {
 	Id              string                                  `json:"Id` //nolint
 	RuntimeDuration int64                                   `json:"runtime_checkpoint_duration"`
 	CRIUStatistics  *define.CRIUCheckpointRestoreStatistics `json:"criu_statistics"`
+	// CheckpointImageID is set when CheckpointOptions.CreateImage was
+	// used, and holds the ID of the resulting checkpoint image.
+	CheckpointImageID string `json:"checkpointImageID,omitempty"`
 }
 
 type RestoreOptions struct {
@@ -214,6 +260,38 @@ type RestoreOptions struct {
 	Pod             string
 	PrintStats      bool
 	FileLocks       bool
+	// ImportImage, if set, restores from a checkpoint previously committed
+	// as an OCI image with CheckpointOptions.CreateImage rather than from a
+	// local tarball. The image is pulled if not already present locally.
+	ImportImage string
+	// Precheck, if set, validates that the images, networks and volumes
+	// required by the checkpoint are available and reports the outcome
+	// without restoring the container.
+	Precheck bool
+}
+
+// RestorePrecheckReport describes whether the resources a checkpoint
+// depends on (image, networks, named volumes) are in a state that allows
+// the checkpoint to be restored.
+type RestorePrecheckReport struct {
+	// MissingImage is set to the rootfs image reference used by the
+	// checkpointed container if that image cannot be found locally.
+	MissingImage string `json:"missingImage,omitempty"`
+	// MissingNetworks lists networks referenced by the checkpoint that
+	// do not exist locally.
+	MissingNetworks []string `json:"missingNetworks,omitempty"`
+	// ConflictingVolumes lists named volumes referenced by the checkpoint
+	// that already exist locally and would collide with the volumes the
+	// checkpoint would otherwise recreate.
+	ConflictingVolumes []string `json:"conflictingVolumes,omitempty"`
+	// MissingVolumes lists named volumes required by the checkpoint's
+	// --ignore-volumes restore that do not exist locally.
+	MissingVolumes []string `json:"missingVolumes,omitempty"`
+}
+
+// OK reports whether the precheck found no obstacles to restoring.
+func (r *RestorePrecheckReport) OK() bool {
+	return r.MissingImage == "" && len(r.MissingNetworks) == 0 && len(r.ConflictingVolumes) == 0 && len(r.MissingVolumes) == 0
 }
 
 type RestoreReport struct {
@@ -221,6 +299,9 @@ type RestoreReport struct {
 	Id              string                                  `json:"Id` //nolint
 	RuntimeDuration int64                                   `json:"runtime_restore_duration"`
 	CRIUStatistics  *define.CRIUCheckpointRestoreStatistics `json:"criu_statistics"`
+	// PrecheckReport is set instead of the above fields when
+	// RestoreOptions.Precheck was used: no restore was attempted.
+	PrecheckReport *RestorePrecheckReport `json:"precheckReport,omitempty"`
 }
 
 type ContainerCreateReport struct {
@@ -257,6 +338,12 @@ type ContainerLogsOptions struct {
 	Tail int64
 	// Show timestamps in the logs.
 	Timestamps bool
+	// Colorize the container name prefix in multi-container output.
+	Colors bool
+	// Grep filters logs to lines matching this RE2 regular expression.
+	Grep string
+	// GrepInvert inverts the Grep match, showing only non-matching lines.
+	GrepInvert bool
 	// Write the stdout to this Writer.
 	StdoutWriter io.Writer
 	// Write the stderr to this Writer.
@@ -316,7 +403,9 @@ type ContainerListOptions struct {
 	Last      int
 	Latest    bool
 	Namespace bool
+	Offset    int
 	Pod       bool
+	Quick     bool
 	Quiet     bool
 	Size      bool
 	External  bool
@@ -381,7 +470,7 @@ type ContainerInitReport struct {
 	Id  string //nolint
 }
 
-//ContainerMountOptions describes the input values for mounting containers
+// ContainerMountOptions describes the input values for mounting containers
 // in the CLI
 type ContainerMountOptions struct {
 	All        bool
@@ -459,6 +548,25 @@ type ContainerStatsReport struct {
 	Stats []define.ContainerStats
 }
 
+// ContainerAccountingOptions describes input options for getting
+// persisted resource accounting on containers.
+type ContainerAccountingOptions struct {
+	// Operate on the latest known container.  Only supported for local
+	// clients.
+	Latest bool
+}
+
+// ContainerAccountingReport describes the resource accounting recorded
+// for a single container.
+type ContainerAccountingReport struct {
+	// Id of the container the accounting data belongs to.
+	Id string //nolint
+	// Name of the container the accounting data belongs to.
+	Name string
+	// ResourceAccounting is nil if the container has not yet exited.
+	ResourceAccounting *define.ContainerResourceAccounting
+}
+
 // ContainerRenameOptions describes input options for renaming a container.
 type ContainerRenameOptions struct {
 	// NewName is the new name that will be given to the container.
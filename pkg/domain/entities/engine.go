@@ -49,7 +49,8 @@ type PodmanConfig struct {
 	Trace          bool       // Hidden: Trace execution
 	URI            string     // URI to RESTful API Service
 
-	Runroot       string
-	StorageDriver string
-	StorageOpts   []string
+	Runroot        string
+	StorageDriver  string
+	StorageOpts    []string
+	TransientStore bool
 }
@@ -3,6 +3,7 @@ package entities
 import (
 	"context"
 
+	"github.com/containers/podman/v4/libpod/define"
 	"github.com/spf13/pflag"
 )
 
@@ -10,5 +11,7 @@ type SystemEngine interface {
 	Renumber(ctx context.Context, flags *pflag.FlagSet, config *PodmanConfig) error
 	Migrate(ctx context.Context, flags *pflag.FlagSet, config *PodmanConfig, options SystemMigrateOptions) error
 	Reset(ctx context.Context) error
+	Locks(ctx context.Context) (*define.SystemLocksReport, error)
+	Check(ctx context.Context, options SystemCheckOptions) (*define.CheckReport, error)
 	Shutdown(ctx context.Context)
 }
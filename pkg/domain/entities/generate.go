@@ -32,6 +32,13 @@ type GenerateSystemdOptions struct {
 	After []string
 	// Requires - systemd requires list for the container or pods
 	Requires []string
+	// AdditionalConfig - additional drop-in style configuration snippets
+	// appended verbatim to the end of the generated unit(s).
+	AdditionalConfig []string
+	// SocketActivated - omit the [Install] section, since the unit is
+	// meant to be started on demand by a matching ".socket" unit rather
+	// than enabled directly.
+	SocketActivated bool
 }
 
 // GenerateSystemdReport
@@ -53,3 +60,12 @@ type GenerateKubeReport struct {
 	// Reader - the io.Reader to reader the generated YAML file.
 	Reader io.Reader
 }
+
+// GenerateSeccompOptions control the generation of a seccomp profile.
+type GenerateSeccompOptions struct{}
+
+// GenerateSeccompReport
+type GenerateSeccompReport struct {
+	// Seccomp - the generated seccomp profile, in JSON form.
+	Seccomp string
+}
@@ -251,6 +251,12 @@ type ImageSearchReport struct {
 type ImageListOptions struct {
 	All    bool     `json:"all" schema:"all"`
 	Filter []string `json:"Filter,omitempty"`
+	// Limit restricts the number of images returned, most-recently
+	// created first. A value <= 0 means no limit.
+	Limit int `json:"limit,omitempty" schema:"limit"`
+	// Offset skips over the first n most-recently created images
+	// before Limit is applied.
+	Offset int `json:"offset,omitempty" schema:"offset"`
 }
 
 type ImagePruneOptions struct {
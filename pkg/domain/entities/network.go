@@ -73,6 +73,14 @@ type NetworkConnectOptions struct {
 	types.PerNetworkOptions
 }
 
+// NetworkUpdateOptions describes options to update a network's per-container
+// settings, such as DNS aliases, without recreating the network connection.
+type NetworkUpdateOptions struct {
+	Container     string   `json:"container"`
+	AddAliases    []string `json:"add_aliases,omitempty"`
+	RemoveAliases []string `json:"remove_aliases,omitempty"`
+}
+
 // NetworkPruneReport containers the name of network and an error
 // associated in its pruning (removal)
 // swagger:model NetworkPruneReport
@@ -2,6 +2,8 @@ package entities
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/specgen"
 	"github.com/containers/podman/v4/pkg/util"
+	"github.com/docker/go-units"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
@@ -63,6 +66,25 @@ type PodUnpauseReport struct {
 	Id   string //nolint
 }
 
+// PodUpdateOptions are options to update settings shared across a pod --
+// the resource limits enforced on its own Cgroup, its restart policy, and
+// its labels -- without recreating the pod.
+type PodUpdateOptions struct {
+	// Memory limit enforced on the pod's cgroup, in the same format
+	// accepted by --memory at pod creation.
+	Memory string
+	// PidsLimit tunes the pod's PIDs limit. A value of 0 leaves it
+	// unchanged.
+	PidsLimit int64
+	// Restart is the restart policy to apply to the pod's infra
+	// container, in the same format accepted by --restart at pod
+	// creation. Governs infra (and thus pod) recreation on future exits.
+	Restart string
+	// Labels are added to the pod, overwriting any existing labels with
+	// the same keys.
+	Labels map[string]string
+}
+
 type PodStopOptions struct {
 	All     bool
 	Ignore  bool
@@ -100,6 +122,7 @@ type PodRmOptions struct {
 	Force   bool
 	Ignore  bool
 	Latest  bool
+	Volumes bool
 	Timeout *uint
 }
 
@@ -136,9 +159,13 @@ type PodCreateOptions struct {
 	Pid                string            `json:"pid,omitempty"`
 	Cpus               float64           `json:"cpus,omitempty"`
 	CpusetCpus         string            `json:"cpuset_cpus,omitempty"`
+	Memory             string            `json:"memory,omitempty"`
+	PidsLimit          int64             `json:"pids_limit,omitempty"`
+	Restart            string            `json:"restart,omitempty"`
 	Userns             specgen.Namespace `json:"-"`
 	Volume             []string          `json:"volume,omitempty"`
 	VolumesFrom        []string          `json:"volumes_from,omitempty"`
+	Secrets            []string          `json:"secrets,omitempty"`
 	SecurityOpt        []string          `json:"security_opt,omitempty"`
 	Sysctl             []string          `json:"sysctl,omitempty"`
 }
@@ -164,6 +191,7 @@ type ContainerCreateOptions struct {
 	CgroupParent      string `json:"cgroup_parent,omitempty"`
 	CIDFile           string
 	ConmonPIDFile     string `json:"container_conmon_pidfile,omitempty"`
+	CPUAffinity       string
 	CPUPeriod         uint64
 	CPUQuota          int64
 	CPURTPeriod       uint64
@@ -228,6 +256,7 @@ type ContainerCreateOptions struct {
 	ReadOnly          bool
 	ReadOnlyTmpFS     bool
 	Restart           string
+	CheckpointOnStop  bool
 	Replace           bool
 	Requires          []string
 	Rm                bool
@@ -262,6 +291,7 @@ type ContainerCreateOptions struct {
 	VolumesFrom       []string `json:"volumes_from,omitempty"`
 	Workdir           string
 	SeccompPolicy     string
+	SeccompTrace      bool
 	PidFile           string
 	ChrootDirs        []string
 	IsInfra           bool
@@ -330,6 +360,25 @@ func ToPodSpecGen(s specgen.PodSpecGenerator, p *PodCreateOptions) (*specgen.Pod
 	s.ShareParent = p.ShareParent
 	s.PodCreateCommand = p.CreateCommand
 	s.VolumesFrom = p.VolumesFrom
+	if len(p.Volume) > 0 {
+		mounts, volumes, overlayVolumes, err := specgen.GenVolumeMounts(p.Volume)
+		if err != nil {
+			return nil, err
+		}
+		if len(mounts) > 0 || len(overlayVolumes) > 0 {
+			return nil, errors.New("only named volumes can be shared across a pod; host-path and overlay volumes are not supported with --volume at pod create time")
+		}
+		for _, vol := range volumes {
+			s.Volumes = append(s.Volumes, vol)
+		}
+	}
+	if len(p.Secrets) > 0 {
+		secrs, err := parsePodSecrets(p.Secrets)
+		if err != nil {
+			return nil, err
+		}
+		s.Secrets = secrs
+	}
 
 	// Networking config
 
@@ -364,6 +413,39 @@ func ToPodSpecGen(s specgen.PodSpecGenerator, p *PodCreateOptions) (*specgen.Pod
 			s.CPUQuota = *cpuDat.Quota
 		}
 	}
+	if p.Memory != "" {
+		memLimit, err := units.RAMInBytes(p.Memory)
+		if err != nil {
+			return nil, errors.New("invalid value for memory")
+		}
+		s.ResourceLimits.Memory = &specs.LinuxMemory{Limit: &memLimit}
+	}
+	if p.PidsLimit != 0 {
+		s.ResourceLimits.Pids = &specs.LinuxPids{Limit: p.PidsLimit}
+	}
+	if p.Restart != "" {
+		splitRestart := strings.Split(p.Restart, ":")
+		switch len(splitRestart) {
+		case 1:
+			// No retries specified
+		case 2:
+			if strings.ToLower(splitRestart[0]) != "on-failure" {
+				return nil, errors.New("restart policy retries can only be specified with on-failure restart policy")
+			}
+			retries, err := strconv.Atoi(splitRestart[1])
+			if err != nil {
+				return nil, fmt.Errorf("parsing restart policy retry count: %w", err)
+			}
+			if retries < 0 {
+				return nil, errors.New("must specify restart policy retry count as a number greater than or equal to 0")
+			}
+			retriesUint := uint(retries)
+			s.RestartRetries = &retriesUint
+		default:
+			return nil, errors.New("invalid restart policy: may specify retries at most once")
+		}
+		s.RestartPolicy = splitRestart[0]
+	}
 	s.Userns = p.Userns
 	sysctl := map[string]string{}
 	if ctl := p.Sysctl; len(ctl) > 0 {
@@ -469,6 +551,70 @@ func ValidatePodStatsOptions(args []string, options *PodStatsOptions) error {
 	}
 }
 
+// parsePodSecrets parses --secret values given to podman pod create into
+// mount-type secrets shared by every container joined to the pod. Unlike the
+// container-level --secret, pod-scoped secrets only support the "mount" type,
+// since there is no single process to receive an environment variable.
+func parsePodSecrets(secrets []string) ([]specgen.Secret, error) {
+	parsed := make([]specgen.Secret, 0, len(secrets))
+	for _, val := range secrets {
+		source := ""
+		target := ""
+		var uid, gid uint64
+		// default mode 444 octal = 292 decimal
+		mode := uint64(292)
+
+		split := strings.Split(val, ",")
+		if len(split) == 1 {
+			parsed = append(parsed, specgen.Secret{Source: val, Mode: uint32(mode)})
+			continue
+		}
+		if !strings.Contains(split[0], "=") {
+			source = split[0]
+			split = split[1:]
+		}
+		for _, opt := range split {
+			kv := strings.SplitN(opt, "=", 2)
+			if len(kv) < 2 {
+				return nil, fmt.Errorf("option %s must be in the form option=value", opt)
+			}
+			var err error
+			switch kv[0] {
+			case "source":
+				source = kv[1]
+			case "target":
+				target = kv[1]
+			case "uid":
+				uid, err = strconv.ParseUint(kv[1], 10, 32)
+			case "gid":
+				gid, err = strconv.ParseUint(kv[1], 10, 32)
+			case "mode":
+				mode, err = strconv.ParseUint(kv[1], 8, 32)
+			case "type":
+				if kv[1] != "mount" {
+					err = errors.New(`pod-scoped secrets only support the "mount" type`)
+				}
+			default:
+				err = fmt.Errorf("secret option %q is invalid", kv[0])
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if source == "" {
+			return nil, fmt.Errorf("no source given for secret %q", val)
+		}
+		parsed = append(parsed, specgen.Secret{
+			Source: source,
+			Target: target,
+			UID:    uint32(uid),
+			GID:    uint32(gid),
+			Mode:   uint32(mode),
+		})
+	}
+	return parsed, nil
+}
+
 // Converts PodLogOptions to ContainerLogOptions
 func PodLogsOptionsToContainerLogsOptions(options PodLogsOptions) ContainerLogsOptions {
 	// PodLogsOptions are similar but contains few extra fields like ctrName
@@ -482,6 +628,7 @@ func PodLogsOptionsToContainerLogsOptions(options PodLogsOptions) ContainerLogsO
 		Until:        options.Until,
 		Tail:         options.Tail,
 		Timestamps:   options.Timestamps,
+		Colors:       options.Colors,
 		StdoutWriter: options.StdoutWriter,
 		StderrWriter: options.StderrWriter,
 	}
@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePodSecretsDefaults(t *testing.T) {
+	secrets, err := parsePodSecrets([]string{"mysecret"})
+	assert.NoError(t, err)
+	assert.Len(t, secrets, 1)
+	assert.Equal(t, "mysecret", secrets[0].Source)
+	assert.Equal(t, "", secrets[0].Target)
+	assert.Equal(t, uint32(0), secrets[0].UID)
+	assert.Equal(t, uint32(0), secrets[0].GID)
+	assert.Equal(t, uint32(292), secrets[0].Mode)
+}
+
+func TestParsePodSecretsWithOptions(t *testing.T) {
+	secrets, err := parsePodSecrets([]string{"mysecret,target=/run/secret,uid=1000,gid=1000,mode=0400"})
+	assert.NoError(t, err)
+	assert.Len(t, secrets, 1)
+	assert.Equal(t, "mysecret", secrets[0].Source)
+	assert.Equal(t, "/run/secret", secrets[0].Target)
+	assert.Equal(t, uint32(1000), secrets[0].UID)
+	assert.Equal(t, uint32(1000), secrets[0].GID)
+	assert.Equal(t, uint32(0400), secrets[0].Mode)
+}
+
+func TestParsePodSecretsRejectsEnvType(t *testing.T) {
+	_, err := parsePodSecrets([]string{"mysecret,type=env"})
+	assert.Error(t, err)
+}
+
+func TestParsePodSecretsRequiresSource(t *testing.T) {
+	_, err := parsePodSecrets([]string{"target=/run/secret,uid=1000"})
+	assert.Error(t, err)
+}
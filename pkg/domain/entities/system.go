@@ -10,10 +10,19 @@ import (
 
 // ServiceOptions provides the input for starting an API and sidecar pprof services
 type ServiceOptions struct {
-	CorsHeaders string        // Cross-Origin Resource Sharing (CORS) headers
-	PProfAddr   string        // Network address to bind pprof profiles service
-	Timeout     time.Duration // Duration of inactivity the service should wait before shutting down
-	URI         string        // Path to unix domain socket service should listen on
+	CorsHeaders              string        // Cross-Origin Resource Sharing (CORS) headers
+	PProfAddr                string        // Network address to bind pprof profiles service
+	Timeout                  time.Duration // Duration of inactivity the service should wait before shutting down
+	URI                      string        // Path to unix domain socket service should listen on
+	TLSCert                  string        // Path to TLS certificate, enables TLS on a tcp:// URI when set
+	TLSKey                   string        // Path to TLS private key for TLSCert
+	TLSCACert                string        // Path to a CA certificate used to require and verify client certificates
+	AuthzPlugin              string        // Unix socket path of an external authorization plugin to consult for each request
+	AuthzPolicyFile          string        // Path to a local authorization policy file to consult for each request
+	RateLimit                uint          // Maximum requests per second accepted from a single client, 0 disables limiting
+	AuditLogPath             string        // Path of a structured audit log to write an entry to for every request
+	IdempotencyWindow        time.Duration // How long an Idempotency-Key header value is remembered for, 0 disables tracking
+	StopContainersOnShutdown bool          // Stop running containers, in reverse dependency order, when the service is shut down by a signal
 }
 
 // SystemPruneOptions provides options to prune system.
@@ -36,6 +45,13 @@ type SystemPruneReport struct {
 // cli to migrate runtimes of containers
 type SystemMigrateOptions struct {
 	NewRuntime string
+	NewDB      string
+}
+
+// SystemCheckOptions describes the options needed for checking and repairing
+// storage and state consistency.
+type SystemCheckOptions struct {
+	Repair bool
 }
 
 // SystemDfOptions describes the options for getting df information
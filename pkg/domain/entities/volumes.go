@@ -118,6 +118,12 @@ type VolumePruneOptions struct {
 
 type VolumeListOptions struct {
 	Filter map[string][]string
+	// Limit restricts the number of volumes returned, most-recently
+	// created first. A value <= 0 means no limit.
+	Limit int
+	// Offset skips over the first n most-recently created volumes
+	// before Limit is applied.
+	Offset int
 }
 
 type VolumeListReport struct {
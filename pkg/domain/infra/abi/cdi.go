@@ -0,0 +1,65 @@
+package abi
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/container-orchestrated-devices/container-device-interface/pkg/cdi"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/pkg/errors"
+)
+
+// CDIList returns every device advertised by the CDI spec files podman
+// looks at when resolving `--device <vendor>/<class>=<name>`.
+func (ic *ContainerEngine) CDIList(_ context.Context) ([]*entities.CDIDevice, error) {
+	registry := cdi.GetRegistry()
+	if err := registry.Refresh(); err != nil {
+		return nil, err
+	}
+
+	names := registry.DeviceDB().ListDevices()
+	devices := make([]*entities.CDIDevice, 0, len(names))
+	for _, name := range names {
+		dev := registry.DeviceDB().GetDevice(name)
+		if dev == nil {
+			continue
+		}
+		devices = append(devices, &entities.CDIDevice{
+			QualifiedName: dev.GetQualifiedName(),
+			Vendor:        dev.GetSpec().GetVendor(),
+			Class:         dev.GetSpec().GetClass(),
+			SpecFile:      dev.GetSpec().GetPath(),
+		})
+	}
+	return devices, nil
+}
+
+// CDIInspect returns the container edits a single CDI device would apply,
+// so users can see what a `--device vendor/class=name` will do before
+// using it.
+func (ic *ContainerEngine) CDIInspect(_ context.Context, qualifiedName string) (*entities.CDIInspectReport, error) {
+	registry := cdi.GetRegistry()
+	if err := registry.Refresh(); err != nil {
+		return nil, err
+	}
+
+	dev := registry.DeviceDB().GetDevice(qualifiedName)
+	if dev == nil {
+		return nil, errors.Errorf("no such CDI device: %q", qualifiedName)
+	}
+
+	edits, err := json.MarshalIndent(dev.ContainerEdits, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.CDIInspectReport{
+		CDIDevice: entities.CDIDevice{
+			QualifiedName: dev.GetQualifiedName(),
+			Vendor:        dev.GetSpec().GetVendor(),
+			Class:         dev.GetSpec().GetClass(),
+			SpecFile:      dev.GetSpec().GetPath(),
+		},
+		ContainerEdits: edits,
+	}, nil
+}
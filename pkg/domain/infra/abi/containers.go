@@ -3,14 +3,17 @@ package abi
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/containers/buildah"
+	"github.com/containers/common/libimage"
 	"github.com/containers/common/pkg/cgroups"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/image/v5/manifest"
@@ -33,8 +36,11 @@ import (
 	"github.com/containers/podman/v4/pkg/specgenutil"
 	"github.com/containers/podman/v4/pkg/util"
 	"github.com/containers/storage"
+	"github.com/containers/storage/pkg/archive"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
 )
 
 // getContainersAndInputByContext gets containers whether all, latest, or a slice of names/ids
@@ -77,6 +83,28 @@ func getContainersByContext(all, latest bool, names []string, runtime *libpod.Ru
 	return
 }
 
+// ContainerAccounting returns the persisted resource accounting recorded for
+// each container at its last exit, if any.
+func (ic *ContainerEngine) ContainerAccounting(ctx context.Context, namesOrIds []string, options entities.ContainerAccountingOptions) ([]*entities.ContainerAccountingReport, error) {
+	ctrs, err := getContainersByContext(false, options.Latest, namesOrIds, ic.Libpod)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*entities.ContainerAccountingReport, 0, len(ctrs))
+	for _, c := range ctrs {
+		accounting, err := c.ResourceAccounting()
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, &entities.ContainerAccountingReport{
+			Id:                 c.ID(),
+			Name:               c.Name(),
+			ResourceAccounting: accounting,
+		})
+	}
+	return reports, nil
+}
+
 // ContainerExists returns whether the container exists in container storage
 func (ic *ContainerEngine) ContainerExists(ctx context.Context, nameOrID string, options entities.ContainerExistsOptions) (*entities.BoolReport, error) {
 	_, err := ic.Libpod.LookupContainer(nameOrID)
@@ -146,6 +174,170 @@ func (ic *ContainerEngine) ContainerUnpause(ctx context.Context, namesOrIds []st
 	}
 	return report, nil
 }
+
+func (ic *ContainerEngine) ContainerUpdate(ctx context.Context, namesOrIds []string, options entities.ContainerUpdateOptions) ([]*entities.ContainerUpdateReport, error) {
+	rules := make([]specs.LinuxDeviceCgroup, 0, len(options.DeviceCgroupRule))
+	for _, rule := range options.DeviceCgroupRule {
+		dev, err := specgenutil.ParseLinuxResourcesDeviceAccess(rule)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, dev)
+	}
+
+	blockIO, err := blockIOResourcesFromUpdateOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrs, err := getContainersByContext(false, false, namesOrIds, ic.Libpod)
+	if err != nil {
+		return nil, err
+	}
+	report := make([]*entities.ContainerUpdateReport, 0, len(ctrs))
+	for _, c := range ctrs {
+		err := c.Update(&specs.LinuxResources{Devices: rules, BlockIO: blockIO})
+		report = append(report, &entities.ContainerUpdateReport{Id: c.ID(), Err: err})
+	}
+	return report, nil
+}
+
+// blockIOResourcesFromUpdateOptions translates the block I/O related fields
+// of a ContainerUpdateOptions into a LinuxBlockIO, using the same parsing and
+// validation rules as container creation. It returns nil if no block I/O
+// option was requested.
+func blockIOResourcesFromUpdateOptions(options entities.ContainerUpdateOptions) (*specs.LinuxBlockIO, error) {
+	blockIO := &specs.LinuxBlockIO{}
+	hasLimits := false
+
+	if options.BlkIOWeight != "" {
+		weight, err := strconv.ParseUint(options.BlkIOWeight, 10, 16)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for blkio-weight")
+		}
+		w := uint16(weight)
+		blockIO.Weight = &w
+		hasLimits = true
+	}
+	if len(options.BlkIOWeightDevice) > 0 {
+		weightDevices, err := specgenutil.ParseWeightDevices(options.BlkIOWeightDevice)
+		if err != nil {
+			return nil, err
+		}
+		if blockIO.WeightDevice, err = deviceWeightsToSlice(weightDevices); err != nil {
+			return nil, err
+		}
+		hasLimits = true
+	}
+	if len(options.DeviceReadBPs) > 0 {
+		throttleDevices, err := specgenutil.ParseThrottleBPSDevices(options.DeviceReadBPs)
+		if err != nil {
+			return nil, err
+		}
+		if blockIO.ThrottleReadBpsDevice, err = throttleDevicesToSlice(throttleDevices); err != nil {
+			return nil, err
+		}
+		hasLimits = true
+	}
+	if len(options.DeviceWriteBPs) > 0 {
+		throttleDevices, err := specgenutil.ParseThrottleBPSDevices(options.DeviceWriteBPs)
+		if err != nil {
+			return nil, err
+		}
+		if blockIO.ThrottleWriteBpsDevice, err = throttleDevicesToSlice(throttleDevices); err != nil {
+			return nil, err
+		}
+		hasLimits = true
+	}
+	if len(options.DeviceReadIOPs) > 0 {
+		throttleDevices, err := specgenutil.ParseThrottleIOPSDevices(options.DeviceReadIOPs)
+		if err != nil {
+			return nil, err
+		}
+		if blockIO.ThrottleReadIOPSDevice, err = throttleDevicesToSlice(throttleDevices); err != nil {
+			return nil, err
+		}
+		hasLimits = true
+	}
+	if len(options.DeviceWriteIOPs) > 0 {
+		throttleDevices, err := specgenutil.ParseThrottleIOPSDevices(options.DeviceWriteIOPs)
+		if err != nil {
+			return nil, err
+		}
+		if blockIO.ThrottleWriteIOPSDevice, err = throttleDevicesToSlice(throttleDevices); err != nil {
+			return nil, err
+		}
+		hasLimits = true
+	}
+
+	if !hasLimits {
+		return nil, nil
+	}
+
+	if blockIO.Weight != nil && (*blockIO.Weight > 10000 || *blockIO.Weight < 1) {
+		return nil, errors.New("range of blkio weight is from 1 to 10000")
+	}
+	if rootless.IsRootless() {
+		controllers, err := cgroups.GetAvailableControllers(nil, true)
+		if err != nil {
+			return nil, err
+		}
+		delegated := false
+		for _, controller := range controllers {
+			if controller == "io" {
+				delegated = true
+				break
+			}
+		}
+		if !delegated {
+			return nil, errors.New("cannot set io limits without the io controller being delegated to the rootless user; see podman-run(1) 'io' section for how to enable it")
+		}
+	}
+
+	return blockIO, nil
+}
+
+// deviceWeightsToSlice resolves the major/minor numbers of a map of device
+// path to weight (as produced by specgenutil.ParseWeightDevices) into the
+// slice form used by the OCI spec.
+func deviceWeightsToSlice(weightDevices map[string]specs.LinuxWeightDevice) ([]specs.LinuxWeightDevice, error) {
+	devices := make([]specs.LinuxWeightDevice, 0, len(weightDevices))
+	for path, dev := range weightDevices {
+		major, minor, err := deviceMajorMinor(path)
+		if err != nil {
+			return nil, err
+		}
+		dev.Major, dev.Minor = major, minor
+		devices = append(devices, dev)
+	}
+	return devices, nil
+}
+
+// throttleDevicesToSlice resolves the major/minor numbers of a map of device
+// path to rate (as produced by specgenutil.ParseThrottle*Devices) into the
+// slice form used by the OCI spec.
+func throttleDevicesToSlice(throttleDevices map[string]specs.LinuxThrottleDevice) ([]specs.LinuxThrottleDevice, error) {
+	devices := make([]specs.LinuxThrottleDevice, 0, len(throttleDevices))
+	for path, dev := range throttleDevices {
+		major, minor, err := deviceMajorMinor(path)
+		if err != nil {
+			return nil, err
+		}
+		dev.Major, dev.Minor = major, minor
+		devices = append(devices, dev)
+	}
+	return devices, nil
+}
+
+// deviceMajorMinor returns the major/minor device numbers of the device node
+// at path.
+func deviceMajorMinor(path string) (int64, int64, error) {
+	statT := unix.Stat_t{}
+	if err := unix.Stat(path, &statT); err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to inspect '%s'", path)
+	}
+	return int64(unix.Major(uint64(statT.Rdev))), int64(unix.Minor(uint64(statT.Rdev))), nil
+}
 func (ic *ContainerEngine) ContainerStop(ctx context.Context, namesOrIds []string, options entities.StopOptions) ([]*entities.StopReport, error) {
 	names := namesOrIds
 	ctrs, rawInputs, err := getContainersAndInputByContext(options.All, options.Latest, names, ic.Libpod)
@@ -565,6 +757,18 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 		FileLocks:      options.FileLocks,
 	}
 
+	if options.CreateImage != "" && checkOpts.TargetFile == "" {
+		// The image importer needs a tarball to work from; produce one
+		// even if the user did not ask to keep an on-disk export.
+		f, err := ioutil.TempFile("", "checkpoint-image-*.tar")
+		if err != nil {
+			return nil, errors.Wrap(err, "creating temporary checkpoint export file")
+		}
+		f.Close()
+		defer os.Remove(f.Name())
+		checkOpts.TargetFile = f.Name()
+	}
+
 	if options.All {
 		running := func(c *libpod.Container) bool {
 			state, _ := c.State()
@@ -580,22 +784,106 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 	reports := make([]*entities.CheckpointReport, 0, len(cons))
 	for _, con := range cons {
 		criuStatistics, runtimeCheckpointDuration, err := con.Checkpoint(ctx, checkOpts)
-		reports = append(reports, &entities.CheckpointReport{
+		report := &entities.CheckpointReport{
 			Err:             err,
 			Id:              con.ID(),
 			RuntimeDuration: runtimeCheckpointDuration,
 			CRIUStatistics:  criuStatistics,
-		})
+		}
+		if err == nil && options.CreateImage != "" {
+			imageID, ierr := ic.checkpointToImage(ctx, checkOpts.TargetFile, options.CreateImage)
+			if ierr != nil {
+				report.Err = ierr
+			} else {
+				report.CheckpointImageID = imageID
+			}
+		}
+		reports = append(reports, report)
 	}
 	return reports, nil
 }
 
+// checkpointToImage commits the checkpoint tarball at tarFile as a new,
+// single-layer OCI image tagged reference, so it can be pushed to a
+// registry and restored on another host via ContainerRestore's ImportImage.
+func (ic *ContainerEngine) checkpointToImage(ctx context.Context, tarFile, reference string) (string, error) {
+	importOptions := &libimage.ImportOptions{
+		Tag:           reference,
+		CommitMessage: "checkpoint image created by podman container checkpoint --create-image",
+	}
+	return ic.Libpod.LibimageRuntime().Import(ctx, tarFile, importOptions)
+}
+
+// checkpointImageToTarball pulls (if needed) the checkpoint image reference,
+// mounts it and re-packs its contents into a tarball in the same layout
+// CRImportCheckpoint expects, so that a checkpoint committed with
+// checkpointToImage can be restored again via RestoreOptions.ImportImage.
+func (ic *ContainerEngine) checkpointImageToTarball(ctx context.Context, reference string) (string, error) {
+	image, _, err := ic.Libpod.LibimageRuntime().LookupImage(reference, nil)
+	if err != nil {
+		pulled, pullErr := ic.Libpod.LibimageRuntime().Pull(ctx, reference, config.PullPolicyMissing, nil)
+		if pullErr != nil || len(pulled) == 0 {
+			return "", errors.Wrapf(err, "checkpoint image %q not found locally and could not be pulled", reference)
+		}
+		image = pulled[0]
+	}
+
+	mountPoint, err := image.Mount(ctx, nil, "")
+	if err != nil {
+		return "", errors.Wrapf(err, "mounting checkpoint image %q", reference)
+	}
+	defer func() {
+		if err := image.Unmount(false); err != nil {
+			logrus.Errorf("Unmounting checkpoint image %q: %v", reference, err)
+		}
+	}()
+
+	f, err := ioutil.TempFile("", "checkpoint-import-*.tar")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	tarContent, err := archive.Tar(mountPoint, archive.Uncompressed)
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	defer tarContent.Close()
+
+	if _, err := io.Copy(f, tarContent); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
 func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []string, options entities.RestoreOptions) ([]*entities.RestoreReport, error) {
 	var (
 		cons []*libpod.Container
 		err  error
 	)
 
+	if options.ImportImage != "" {
+		tarFile, err := ic.checkpointImageToTarball(ctx, options.ImportImage)
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(tarFile)
+		options.Import = tarFile
+	}
+
+	if options.Precheck {
+		if options.Import == "" {
+			return nil, errors.New("--precheck requires --import or --import-image")
+		}
+		report, err := checkpoint.CRRestorePrecheck(ic.Libpod, options)
+		if err != nil {
+			return nil, err
+		}
+		return []*entities.RestoreReport{{PrecheckReport: report}}, nil
+	}
+
 	restoreOptions := libpod.ContainerCheckpointOptions{
 		Keep:            options.Keep,
 		TCPEstablished:  options.TCPEstablished,
@@ -1073,6 +1361,15 @@ func (ic *ContainerEngine) ContainerLogs(ctx context.Context, containers []strin
 		return errors.New("no io.Writer set for container logs")
 	}
 
+	var grepRegex *regexp.Regexp
+	if options.Grep != "" {
+		re, err := regexp.Compile(options.Grep)
+		if err != nil {
+			return errors.Wrapf(err, "invalid --grep regular expression %q", options.Grep)
+		}
+		grepRegex = re
+	}
+
 	var wg sync.WaitGroup
 
 	ctrs, err := getContainersByContext(false, options.Latest, containers, ic.Libpod)
@@ -1089,6 +1386,7 @@ func (ic *ContainerEngine) ContainerLogs(ctx context.Context, containers []strin
 		Tail:       options.Tail,
 		Timestamps: options.Timestamps,
 		UseName:    options.Names,
+		Colors:     options.Colors,
 		WaitGroup:  &wg,
 	}
 
@@ -1107,10 +1405,21 @@ func (ic *ContainerEngine) ContainerLogs(ctx context.Context, containers []strin
 		close(logChannel)
 	}()
 
+	var grepMatches int
 	for line := range logChannel {
+		if grepRegex != nil {
+			if grepRegex.MatchString(line.Msg) == options.GrepInvert {
+				continue
+			}
+			grepMatches++
+		}
 		line.Write(options.StdoutWriter, options.StderrWriter, logOpts)
 	}
 
+	if grepRegex != nil {
+		logrus.Infof("%d log line(s) matched --grep %q", grepMatches, options.Grep)
+	}
+
 	return nil
 }
 
@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/containers/podman/v4/libpod"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	k8sAPI "github.com/containers/podman/v4/pkg/k8s.io/api/core/v1"
+	"github.com/containers/podman/v4/pkg/seccomp"
 	"github.com/containers/podman/v4/pkg/systemd/generate"
 	"github.com/ghodss/yaml"
 	"github.com/pkg/errors"
@@ -250,3 +252,25 @@ func generateKubeOutput(content [][]byte) ([]byte, error) {
 
 	return output, nil
 }
+
+// GenerateSeccomp reads the profile the oci-seccomp-bpf-hook recorded for
+// the container (via `podman run --seccomp-trace`) and returns it.
+func (ic *ContainerEngine) GenerateSeccomp(ctx context.Context, nameOrID string, options entities.GenerateSeccompOptions) (*entities.GenerateSeccompReport, error) {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	trace, ok := ctr.Config().Spec.Annotations[seccomp.TraceAnnotation]
+	if !ok {
+		return nil, errors.Errorf("container %s was not created with --seccomp-trace, no traced profile to generate from", nameOrID)
+	}
+	tracePath := strings.TrimPrefix(trace, "of:")
+
+	profile, err := ioutil.ReadFile(tracePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading traced seccomp profile for container %s", nameOrID)
+	}
+
+	return &entities.GenerateSeccompReport{Seccomp: string(profile)}, nil
+}
@@ -2,6 +2,7 @@ package abi
 
 import (
 	"context"
+	"sort"
 
 	"github.com/containers/common/libimage"
 	"github.com/containers/podman/v4/pkg/domain/entities"
@@ -79,5 +80,21 @@ func (ir *ImageEngine) List(ctx context.Context, opts entities.ImageListOptions)
 
 		summaries = append(summaries, &e)
 	}
+
+	// Sort by creation time, newest first, so Offset/Limit paginate over a
+	// stable order regardless of the backing store's iteration order.
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].Created > summaries[j].Created
+	})
+	if opts.Offset > 0 || opts.Limit > 0 {
+		if opts.Offset < len(summaries) {
+			summaries = summaries[opts.Offset:]
+		} else {
+			summaries = []*entities.ImageSummary{}
+		}
+		if opts.Limit > 0 && opts.Limit < len(summaries) {
+			summaries = summaries[:opts.Limit]
+		}
+	}
 	return summaries, nil
 }
@@ -127,6 +127,31 @@ func (ic *ContainerEngine) NetworkConnect(ctx context.Context, networkname strin
 	return ic.Libpod.ConnectContainerToNetwork(options.Container, networkname, options.PerNetworkOptions)
 }
 
+// NetworkUpdate adds and/or removes network-scoped DNS aliases for a
+// container already connected to the given network.
+func (ic *ContainerEngine) NetworkUpdate(ctx context.Context, networkname string, options entities.NetworkUpdateOptions) error {
+	ctr, err := ic.Libpod.LookupContainer(options.Container)
+	if err != nil {
+		return err
+	}
+
+	aliases, err := ctr.NetworkAliases(networkname)
+	if err != nil {
+		return err
+	}
+
+	for _, alias := range options.AddAliases {
+		if !util.StringInSlice(alias, aliases) {
+			aliases = append(aliases, alias)
+		}
+	}
+	for _, alias := range options.RemoveAliases {
+		aliases = util.StringSliceRemove(aliases, alias)
+	}
+
+	return ic.Libpod.ModifyContainerNetworkOptions(options.Container, networkname, types.PerNetworkOptions{Aliases: aliases})
+}
+
 // NetworkExists checks if the given network exists
 func (ic *ContainerEngine) NetworkExists(ctx context.Context, networkname string) (*entities.BoolReport, error) {
 	_, err := ic.Libpod.Network().NetworkInspect(networkname)
@@ -2,6 +2,8 @@ package abi
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"github.com/containers/podman/v4/libpod"
 	"github.com/containers/podman/v4/libpod/define"
@@ -9,6 +11,8 @@ import (
 	dfilters "github.com/containers/podman/v4/pkg/domain/filters"
 	"github.com/containers/podman/v4/pkg/signal"
 	"github.com/containers/podman/v4/pkg/specgen/generate"
+	"github.com/docker/go-units"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -173,6 +177,71 @@ func (ic *ContainerEngine) PodUnpause(ctx context.Context, namesOrIds []string,
 	return reports, nil
 }
 
+// PodUpdate updates settings shared across a pod -- the resource limits
+// enforced on its own Cgroup, its restart policy, and its labels -- without
+// recreating the pod.
+func (ic *ContainerEngine) PodUpdate(ctx context.Context, nameOrID string, options entities.PodUpdateOptions) error {
+	pod, err := ic.Libpod.LookupPod(nameOrID)
+	if err != nil {
+		return err
+	}
+
+	if options.Memory != "" || options.PidsLimit != 0 {
+		resources := pod.ResourceLimits()
+		if resources == nil {
+			resources = &spec.LinuxResources{}
+		}
+		if options.Memory != "" {
+			memLimit, err := units.RAMInBytes(options.Memory)
+			if err != nil {
+				return errors.Wrapf(err, "invalid value for memory")
+			}
+			resources.Memory = &spec.LinuxMemory{Limit: &memLimit}
+		}
+		if options.PidsLimit != 0 {
+			resources.Pids = &spec.LinuxPids{Limit: options.PidsLimit}
+		}
+		if err := pod.Update(resources); err != nil {
+			return err
+		}
+	}
+
+	if options.Restart != "" {
+		splitRestart := strings.Split(options.Restart, ":")
+		var retries *uint
+		switch len(splitRestart) {
+		case 1:
+			// No retries specified
+		case 2:
+			if strings.ToLower(splitRestart[0]) != "on-failure" {
+				return errors.New("restart policy retries can only be specified with on-failure restart policy")
+			}
+			count, err := strconv.Atoi(splitRestart[1])
+			if err != nil {
+				return errors.Wrapf(err, "error parsing restart policy retry count")
+			}
+			if count < 0 {
+				return errors.New("must specify restart policy retry count as a number greater than or equal to 0")
+			}
+			retriesUint := uint(count)
+			retries = &retriesUint
+		default:
+			return errors.New("invalid restart policy: may specify retries at most once")
+		}
+		if err := pod.UpdateRestartPolicy(splitRestart[0], retries); err != nil {
+			return err
+		}
+	}
+
+	if len(options.Labels) > 0 {
+		if err := pod.AddLabels(options.Labels); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (ic *ContainerEngine) PodStop(ctx context.Context, namesOrIds []string, options entities.PodStopOptions) ([]*entities.PodStopReport, error) {
 	reports := []*entities.PodStopReport{}
 	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
@@ -259,9 +328,26 @@ func (ic *ContainerEngine) PodRm(ctx context.Context, namesOrIds []string, optio
 	reports := make([]*entities.PodRmReport, 0, len(pods))
 	for _, p := range pods {
 		report := entities.PodRmReport{Id: p.ID()}
+		podVolumes := p.Volumes()
 		err := ic.Libpod.RemovePod(ctx, p, true, options.Force, options.Timeout)
 		if err != nil {
 			report.Err = err
+			reports = append(reports, &report)
+			continue
+		}
+		if options.Volumes {
+			for _, v := range podVolumes {
+				volume, err := ic.Libpod.GetVolume(v.Name)
+				if err != nil {
+					if errors.Cause(err) != define.ErrNoSuchVolume {
+						logrus.Errorf("Removing volume %s of pod %s: %v", v.Name, report.Id, err)
+					}
+					continue
+				}
+				if err := ic.Libpod.RemoveVolume(ctx, volume, false, options.Timeout); err != nil && errors.Cause(err) != define.ErrNoSuchVolume {
+					logrus.Errorf("Removing volume %s of pod %s: %v", v.Name, report.Id, err)
+				}
+			}
 		}
 		reports = append(reports, &report)
 	}
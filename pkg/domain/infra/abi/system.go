@@ -11,6 +11,7 @@ import (
 	"github.com/containers/common/pkg/cgroups"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/podman/v4/libpod/define"
+	podmanApparmor "github.com/containers/podman/v4/pkg/apparmor"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/containers/podman/v4/pkg/domain/entities/reports"
 	"github.com/containers/podman/v4/pkg/rootless"
@@ -184,9 +185,58 @@ func (ic *ContainerEngine) SystemPrune(ctx context.Context, options entities.Sys
 		}
 	}
 	systemPruneReport.ReclaimedSpace = reclaimedSpace
+	if err := ic.pruneUnusedApparmorProfiles(); err != nil {
+		logrus.Errorf("Unloading unused AppArmor profiles: %v", err)
+	}
 	return systemPruneReport, nil
 }
 
+// pruneUnusedApparmorProfiles unloads AppArmor profiles Podman itself
+// loaded from a file (see setupApparmor in pkg/specgen/generate) that are
+// no longer referenced by any container.
+func (ic *ContainerEngine) pruneUnusedApparmorProfiles() error {
+	tmpDir, err := ic.Libpod.TmpDir()
+	if err != nil {
+		return err
+	}
+	registry, err := podmanApparmor.NewRegistry(tmpDir)
+	if err != nil {
+		return err
+	}
+	profiles, err := registry.Profiles()
+	if err != nil {
+		return err
+	}
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	ctrs, err := ic.Libpod.GetAllContainers()
+	if err != nil {
+		return err
+	}
+	inUse := make(map[string]bool)
+	for _, ctr := range ctrs {
+		if profile, ok := ctr.Config().Spec.Annotations[define.InspectAnnotationApparmor]; ok {
+			inUse[profile] = true
+		}
+	}
+
+	for name := range profiles {
+		if inUse[name] {
+			continue
+		}
+		if err := podmanApparmor.UnloadProfile(name); err != nil {
+			logrus.Errorf("Unloading AppArmor profile %q: %v", name, err)
+			continue
+		}
+		if err := registry.Remove(name); err != nil {
+			logrus.Errorf("Removing AppArmor profile %q from registry: %v", name, err)
+		}
+	}
+	return nil
+}
+
 func (ic *ContainerEngine) SystemDf(ctx context.Context, options entities.SystemDfOptions) (*entities.SystemDfReport, error) {
 	var (
 		dfImages = []*entities.SystemDfImageReport{}
@@ -334,10 +384,36 @@ func (se *SystemEngine) Renumber(ctx context.Context, flags *pflag.FlagSet, conf
 	return nil
 }
 
-func (se SystemEngine) Migrate(ctx context.Context, flags *pflag.FlagSet, config *entities.PodmanConfig, options entities.SystemMigrateOptions) error {
+func (se SystemEngine) Migrate(ctx context.Context, flags *pflag.FlagSet, cfg *entities.PodmanConfig, options entities.SystemMigrateOptions) error {
+	switch options.NewDB {
+	case "":
+		// No conversion requested.
+	case "boltdb":
+		rtConfig, err := se.Libpod.GetConfigNoCopy()
+		if err != nil {
+			return err
+		}
+		if rtConfig.Engine.StateType != config.BoltDBStateStore {
+			return errors.New("converting to the boltdb state backend is not yet supported")
+		}
+		// Already on BoltDB, nothing to do.
+	case "sqlite":
+		return errors.New("the sqlite state backend is not yet available in this build of podman")
+	default:
+		return errors.Errorf("unrecognized state backend %q, must be one of: boltdb, sqlite", options.NewDB)
+	}
+
 	return nil
 }
 
+func (se SystemEngine) Locks(ctx context.Context) (*define.SystemLocksReport, error) {
+	return se.Libpod.LocksDiagnose()
+}
+
+func (se SystemEngine) Check(ctx context.Context, options entities.SystemCheckOptions) (*define.CheckReport, error) {
+	return se.Libpod.Check(ctx, options.Repair)
+}
+
 func (se SystemEngine) Shutdown(ctx context.Context) {
 	if err := se.Libpod.Shutdown(false); err != nil {
 		logrus.Error(err)
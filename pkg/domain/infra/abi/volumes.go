@@ -2,6 +2,7 @@ package abi
 
 import (
 	"context"
+	"sort"
 
 	"github.com/containers/podman/v4/libpod"
 	"github.com/containers/podman/v4/libpod/define"
@@ -151,6 +152,22 @@ func (ic *ContainerEngine) VolumeList(ctx context.Context, opts entities.VolumeL
 		}
 		reports = append(reports, &entities.VolumeListReport{VolumeConfigResponse: config})
 	}
+
+	// Sort by creation time, newest first, so Offset/Limit paginate over a
+	// stable order regardless of the backing store's iteration order.
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].CreatedAt.After(reports[j].CreatedAt)
+	})
+	if opts.Offset > 0 || opts.Limit > 0 {
+		if opts.Offset < len(reports) {
+			reports = reports[opts.Offset:]
+		} else {
+			reports = []*entities.VolumeListReport{}
+		}
+		if opts.Limit > 0 && opts.Limit < len(reports) {
+			reports = reports[:opts.Limit]
+		}
+	}
 	return reports, nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sync"
 
 	"github.com/containers/common/pkg/cgroups"
@@ -16,6 +17,7 @@ import (
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/containers/podman/v4/pkg/namespaces"
 	"github.com/containers/podman/v4/pkg/rootless"
+	"github.com/containers/storage"
 	"github.com/containers/storage/pkg/idtools"
 	"github.com/containers/storage/types"
 	"github.com/pkg/errors"
@@ -145,6 +147,23 @@ func getRuntime(ctx context.Context, fs *flag.FlagSet, opts *engineOpts) (*libpo
 		// Overriding the default storage driver caused GraphDriverOptions from storage.conf to be ignored
 		storageOpts.GraphDriverOptions = []string{}
 	}
+	if cfg.StorageDriver == "composefs" {
+		return nil, errors.New("the composefs storage driver is not yet supported by this build of podman")
+	}
+	if cfg.TransientStore {
+		storageSet = true
+		if storageOpts.RunRoot == "" {
+			defaultOpts, err := storage.DefaultStoreOptions(rootless.IsRootless(), rootless.GetRootlessUID())
+			if err != nil {
+				return nil, err
+			}
+			storageOpts.RunRoot = defaultOpts.RunRoot
+		}
+		// Transient storage keeps images and container layers on RunRoot, which is
+		// tmpfs-backed by default, so nothing survives a reboot.
+		storageOpts.GraphRoot = filepath.Join(storageOpts.RunRoot, "transient-store")
+		storageOpts.GraphDriverOptions = []string{}
+	}
 	// This should always be checked after storage-driver is checked
 	if len(cfg.StorageOpts) > 0 {
 		storageSet = true
@@ -242,6 +261,14 @@ func getRuntime(ctx context.Context, fs *flag.FlagSet, opts *engineOpts) (*libpo
 		options = append(options, libpod.WithSyslog())
 	}
 
+	if logSeccompAudit, _ := fs.GetBool("log-seccomp-audit"); logSeccompAudit {
+		options = append(options, libpod.WithSeccompAuditEvents())
+	}
+
+	if eventsSnapshot, _ := fs.GetBool("events-snapshot"); eventsSnapshot {
+		options = append(options, libpod.WithEventsSnapshot())
+	}
+
 	// TODO flag to set CNI plugins dir?
 
 	if !opts.withFDS {
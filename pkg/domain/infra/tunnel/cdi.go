@@ -0,0 +1,20 @@
+package tunnel
+
+import (
+	"context"
+
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/pkg/errors"
+)
+
+// CDIList is not supported for remote clients: CDI devices are resolved
+// from spec files on the machine actually running containers, which the
+// remote client has no visibility into.
+func (ic *ContainerEngine) CDIList(_ context.Context) ([]*entities.CDIDevice, error) {
+	return nil, errors.New("listing CDI devices is not supported for remote clients")
+}
+
+// CDIInspect is not supported for remote clients; see CDIList.
+func (ic *ContainerEngine) CDIInspect(_ context.Context, _ string) (*entities.CDIInspectReport, error) {
+	return nil, errors.New("inspecting CDI devices is not supported for remote clients")
+}
@@ -30,6 +30,10 @@ func (ic *ContainerEngine) ContainerRunlabel(ctx context.Context, label string,
 	return errors.New("not implemented")
 }
 
+func (ic *ContainerEngine) ContainerAccounting(ctx context.Context, namesOrIds []string, options entities.ContainerAccountingOptions) ([]*entities.ContainerAccountingReport, error) {
+	return nil, errors.New("resource accounting is not supported for remote clients")
+}
+
 func (ic *ContainerEngine) ContainerExists(ctx context.Context, nameOrID string, options entities.ContainerExistsOptions) (*entities.BoolReport, error) {
 	exists, err := containers.Exists(ic.ClientCtx, nameOrID, new(containers.ExistsOptions).WithExternal(options.External))
 	return &entities.BoolReport{Value: exists}, err
@@ -89,6 +93,12 @@ func (ic *ContainerEngine) ContainerUnpause(ctx context.Context, namesOrIds []st
 	return reports, nil
 }
 
+// ContainerUpdate is not implemented for the remote client: there is no API
+// endpoint (yet) to apply live cgroup resource changes to a container.
+func (ic *ContainerEngine) ContainerUpdate(ctx context.Context, namesOrIds []string, options entities.ContainerUpdateOptions) ([]*entities.ContainerUpdateReport, error) {
+	return nil, errors.New("updating containers is not supported for remote clients")
+}
+
 func (ic *ContainerEngine) ContainerStop(ctx context.Context, namesOrIds []string, opts entities.StopOptions) ([]*entities.StopReport, error) {
 	reports := []*entities.StopReport{}
 	ctrs, rawInputs, err := getContainersAndInputByContext(ic.ClientCtx, opts.All, opts.Ignore, namesOrIds)
@@ -336,6 +346,7 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 	options.WithPreCheckpoint(opts.PreCheckPoint)
 	options.WithLeaveRunning(opts.LeaveRunning)
 	options.WithWithPrevious(opts.WithPrevious)
+	options.WithCreateImage(opts.CreateImage)
 
 	var (
 		err  error
@@ -375,6 +386,9 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 	if opts.ImportPrevious != "" {
 		return nil, fmt.Errorf("--import-previous is not supported on the remote client")
 	}
+	if opts.Precheck {
+		return nil, fmt.Errorf("--precheck is not supported on the remote client")
+	}
 
 	options := new(containers.RestoreOptions)
 	options.WithFileLocks(opts.FileLocks)
@@ -389,6 +403,12 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 	options.WithPrintStats(opts.PrintStats)
 	options.WithPublishPorts(opts.PublishPorts)
 
+	if opts.ImportImage != "" {
+		options.WithImportImage(opts.ImportImage)
+		report, err := containers.Restore(ic.ClientCtx, "", options)
+		return []*entities.RestoreReport{report}, err
+	}
+
 	if opts.Import != "" {
 		options.WithImportArchive(opts.Import)
 		report, err := containers.Restore(ic.ClientCtx, "", options)
@@ -446,6 +466,11 @@ func (ic *ContainerEngine) ContainerLogs(_ context.Context, nameOrIDs []string,
 	stderr := opts.StderrWriter != nil
 	options := new(containers.LogOptions).WithFollow(opts.Follow).WithSince(since).WithUntil(until).WithStderr(stderr)
 	options.WithStdout(stdout).WithTail(tail)
+	if opts.Grep != "" {
+		// Filtering happens server-side so a remote client does not have
+		// to download the full log just to find a handful of lines.
+		options.WithGrep(opts.Grep).WithGrepInvert(opts.GrepInvert)
+	}
 
 	var err error
 	stdoutCh := make(chan string)
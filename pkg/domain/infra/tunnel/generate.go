@@ -5,6 +5,7 @@ import (
 
 	"github.com/containers/podman/v4/pkg/bindings/generate"
 	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/pkg/errors"
 )
 
 func (ic *ContainerEngine) GenerateSystemd(ctx context.Context, nameOrID string, opts entities.GenerateSystemdOptions) (*entities.GenerateSystemdReport, error) {
@@ -43,3 +44,9 @@ func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrIDs []string,
 	options := new(generate.KubeOptions).WithService(opts.Service)
 	return generate.Kube(ic.ClientCtx, nameOrIDs, options)
 }
+
+// GenerateSeccomp is not supported for remote clients, since the traced
+// profile only ever exists on the host actually running the container.
+func (ic *ContainerEngine) GenerateSeccomp(ctx context.Context, nameOrID string, opts entities.GenerateSeccompOptions) (*entities.GenerateSeccompReport, error) {
+	return nil, errors.New("generating a seccomp profile is not supported for remote clients")
+}
@@ -38,7 +38,7 @@ func (ir *ImageEngine) List(ctx context.Context, opts entities.ImageListOptions)
 		f := strings.Split(filter, "=")
 		filters[f[0]] = f[1:]
 	}
-	options := new(images.ListOptions).WithAll(opts.All).WithFilters(filters)
+	options := new(images.ListOptions).WithAll(opts.All).WithFilters(filters).WithLimit(opts.Limit).WithOffset(opts.Offset)
 	psImages, err := images.List(ir.ClientCtx, options)
 	if err != nil {
 		return nil, err
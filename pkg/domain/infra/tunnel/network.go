@@ -84,6 +84,12 @@ func (ic *ContainerEngine) NetworkConnect(ctx context.Context, networkname strin
 	return network.Connect(ic.ClientCtx, networkname, opts.Container, &opts.PerNetworkOptions)
 }
 
+// NetworkUpdate adds and/or removes network-scoped DNS aliases for a
+// container already connected to a network
+func (ic *ContainerEngine) NetworkUpdate(ctx context.Context, networkname string, opts entities.NetworkUpdateOptions) error {
+	return network.Update(ic.ClientCtx, networkname, opts)
+}
+
 // NetworkExists checks if the given network exists
 func (ic *ContainerEngine) NetworkExists(ctx context.Context, networkname string) (*entities.BoolReport, error) {
 	exists, err := network.Exists(ic.ClientCtx, networkname, nil)
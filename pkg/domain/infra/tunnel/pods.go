@@ -2,12 +2,20 @@ package tunnel
 
 import (
 	"context"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/pkg/bindings/containers"
 	"github.com/containers/podman/v4/pkg/bindings/pods"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/containers/podman/v4/pkg/util"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
 func (ic *ContainerEngine) PodExists(ctx context.Context, nameOrID string) (*entities.BoolReport, error) {
@@ -42,14 +50,148 @@ func (ic *ContainerEngine) PodKill(ctx context.Context, namesOrIds []string, opt
 	return reports, nil
 }
 
+// podLogLine is a single, already-fetched log line from one container of a
+// pod, tagged with enough information to merge and prefix it.
+type podLogLine struct {
+	ctrID   string
+	ctrName string
+	time    time.Time
+	msg     string
+	stderr  bool
+}
+
 func (ic *ContainerEngine) PodLogs(_ context.Context, nameOrIDs string, options entities.PodLogsOptions) error {
 	// PodLogsOptions are similar but contains few extra fields like ctrName
 	// So cast other values as is so we can re-use the code
 	containerLogsOpts := entities.PodLogsOptionsToContainerLogsOptions(options)
 
-	// interface only accepts slice, keep everything consistent
-	name := []string{options.ContainerName}
-	return ic.ContainerLogs(nil, name, containerLogsOpts)
+	if options.ContainerName != "" {
+		// A single container was requested; no merging necessary.
+		return ic.ContainerLogs(nil, []string{options.ContainerName}, containerLogsOpts)
+	}
+
+	inspectData, err := pods.Inspect(ic.ClientCtx, nameOrIDs, nil)
+	if err != nil {
+		return err
+	}
+
+	ctrNames := make(map[string]string, len(inspectData.Containers))
+	for _, ctr := range inspectData.Containers {
+		ctrNames[ctr.ID] = ctr.Name
+	}
+	if len(ctrNames) == 0 {
+		return errors.Errorf("pod %s has no containers", nameOrIDs)
+	}
+
+	// Timestamps are always requested from the server so lines from the
+	// different containers can be interleaved in chronological order,
+	// even if the caller did not ask to display them.
+	since := options.Since.Format(time.RFC3339)
+	until := options.Until.Format(time.RFC3339)
+	tail := strconv.FormatInt(options.Tail, 10)
+	logOpts := new(containers.LogOptions).WithFollow(options.Follow).WithSince(since).WithUntil(until)
+	logOpts.WithStdout(true).WithStderr(true).WithTail(tail).WithTimestamps(true)
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		lines []podLogLine
+	)
+	for cid, cname := range ctrNames {
+		wg.Add(1)
+		go func(cid, cname string) {
+			defer wg.Done()
+			stdoutCh := make(chan string)
+			stderrCh := make(chan string)
+			done := make(chan error, 1)
+			go func() {
+				done <- containers.Logs(ic.ClientCtx, cid, logOpts, stdoutCh, stderrCh)
+			}()
+			for {
+				select {
+				case err := <-done:
+					if err != nil {
+						logrus.Errorf("error fetching logs for container %s in pod %s: %v", cname, nameOrIDs, err)
+					}
+					return
+				case line := <-stdoutCh:
+					if pll, ok := parsePodLogLine(cid, cname, line, false); ok {
+						mu.Lock()
+						lines = append(lines, pll)
+						mu.Unlock()
+					}
+				case line := <-stderrCh:
+					if pll, ok := parsePodLogLine(cid, cname, line, true); ok {
+						mu.Lock()
+						lines = append(lines, pll)
+						mu.Unlock()
+					}
+				}
+			}
+		}(cid, cname)
+	}
+	wg.Wait()
+
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].time.Before(lines[j].time) })
+
+	for _, l := range lines {
+		w := options.StdoutWriter
+		if l.stderr && options.StderrWriter != nil {
+			w = options.StderrWriter
+		}
+		if w == nil {
+			continue
+		}
+		_, _ = io.WriteString(w, formatPodLogLine(l, options))
+	}
+	return nil
+}
+
+// parsePodLogLine splits the leading RFC3339 timestamp (always requested
+// from the server) off of a raw log line so lines from multiple containers
+// can be ordered chronologically.
+func parsePodLogLine(cid, cname, raw string, stderr bool) (podLogLine, bool) {
+	raw = strings.TrimSuffix(raw, "\n")
+	parts := strings.SplitN(raw, " ", 2)
+	if len(parts) != 2 {
+		return podLogLine{}, false
+	}
+	t, err := time.Parse(time.RFC3339, parts[0])
+	if err != nil {
+		return podLogLine{}, false
+	}
+	return podLogLine{ctrID: cid, ctrName: cname, time: t, msg: parts[1], stderr: stderr}, true
+}
+
+func formatPodLogLine(l podLogLine, options entities.PodLogsOptions) string {
+	var prefix string
+	if options.Names {
+		prefix = l.ctrName
+	} else {
+		cid := l.ctrID
+		if len(cid) > 12 {
+			cid = cid[:12]
+		}
+		prefix = cid
+	}
+	if options.Colors {
+		prefix = podLogColorFor(l.ctrID) + prefix + "\033[0m"
+	}
+	out := prefix + " "
+	if options.Timestamps {
+		out += l.time.Format(time.RFC3339) + " "
+	}
+	return out + l.msg + "\n"
+}
+
+var podLogColors = []string{"\033[36m", "\033[33m", "\033[32m", "\033[35m", "\033[34m", "\033[31m"}
+
+func podLogColorFor(cid string) string {
+	var sum int
+	for _, c := range cid {
+		sum += int(c)
+	}
+	return podLogColors[sum%len(podLogColors)]
 }
 
 func (ic *ContainerEngine) PodPause(ctx context.Context, namesOrIds []string, options entities.PodPauseOptions) ([]*entities.PodPauseReport, error) {
@@ -73,6 +215,13 @@ func (ic *ContainerEngine) PodPause(ctx context.Context, namesOrIds []string, op
 	return reports, nil
 }
 
+// PodUpdate is not implemented for the remote client: there is no API
+// endpoint (yet) to apply live resource, restart policy, or label changes
+// to a pod.
+func (ic *ContainerEngine) PodUpdate(ctx context.Context, nameOrID string, options entities.PodUpdateOptions) error {
+	return errors.New("updating pods is not supported for remote clients")
+}
+
 func (ic *ContainerEngine) PodUnpause(ctx context.Context, namesOrIds []string, options entities.PodunpauseOptions) ([]*entities.PodUnpauseReport, error) {
 	foundPods, err := getPodsByContext(ic.ClientCtx, options.All, namesOrIds)
 	if err != nil {
@@ -163,6 +312,9 @@ func (ic *ContainerEngine) PodStart(ctx context.Context, namesOrIds []string, op
 }
 
 func (ic *ContainerEngine) PodRm(ctx context.Context, namesOrIds []string, opts entities.PodRmOptions) ([]*entities.PodRmReport, error) {
+	if opts.Volumes {
+		return nil, errors.New("removing a pod's volumes is not supported for remote clients")
+	}
 	foundPods, err := getPodsByContext(ic.ClientCtx, opts.All, namesOrIds)
 	if err != nil && !(opts.Ignore && errors.Cause(err) == define.ErrNoSuchPod) {
 		return nil, err
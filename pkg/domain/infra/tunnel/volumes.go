@@ -80,7 +80,7 @@ func (ic *ContainerEngine) VolumePrune(ctx context.Context, opts entities.Volume
 }
 
 func (ic *ContainerEngine) VolumeList(ctx context.Context, opts entities.VolumeListOptions) ([]*entities.VolumeListReport, error) {
-	options := new(volumes.ListOptions).WithFilters(opts.Filter)
+	options := new(volumes.ListOptions).WithFilters(opts.Filter).WithLimit(opts.Limit).WithOffset(opts.Offset)
 	return volumes.List(ic.ClientCtx, options)
 }
 
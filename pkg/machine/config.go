@@ -29,6 +29,15 @@ type InitOptions struct {
 	Username     string
 	ReExec       bool
 	Rootful      bool
+	// CloudInit is a path to a cloud-init user-data file whose contents
+	// are embedded into the generated Ignition config, so a custom guest
+	// image can pick it up on first boot.
+	CloudInit string
+	// CACertsPath is a path to a PEM file of additional CA certificates
+	// to trust inside the machine. It is re-read and installed into the
+	// guest's trust store on every `podman machine start`, so updating
+	// it does not require reinitializing the machine.
+	CACertsPath string
 	// The numberical userid of the user that called machine
 	UID string
 }
@@ -96,6 +105,59 @@ type ListResponse struct {
 
 type SetOptions struct {
 	Rootful bool
+	// CPUs, Memory and DiskSize are zero when unset, meaning "leave
+	// unchanged". DiskSize can only be grown, never shrunk.
+	CPUs     uint64
+	Memory   uint64
+	DiskSize uint64
+}
+
+// VolumeAddOptions describes a single volume mount to be added to an
+// existing, stopped machine. Volume follows the same host:target[:opts]
+// syntax accepted by `podman machine init --volume`.
+type VolumeAddOptions struct {
+	Volume string
+}
+
+// VolumeRemoveOptions identifies a volume mount to remove from an
+// existing, stopped machine by its mount tag.
+type VolumeRemoveOptions struct {
+	Tag string
+}
+
+// PortMapping describes a single additional host-to-guest port forward,
+// on top of the ones podman machine sets up automatically (e.g. the API
+// socket), maintained by the machine's user-mode networking (gvproxy).
+type PortMapping struct {
+	Protocol  string // "tcp" or "udp"
+	HostIP    string // empty means all host interfaces
+	HostPort  uint16
+	GuestPort uint16
+}
+
+// PortAddOptions describes a port forward to add to an existing, stopped
+// machine.
+type PortAddOptions struct {
+	Mapping PortMapping
+}
+
+// PortRemoveOptions identifies the port forward(s) to remove from an
+// existing, stopped machine.
+type PortRemoveOptions struct {
+	All     bool
+	Mapping PortMapping
+}
+
+// SnapshotCreateOptions names a new internal disk-image snapshot to take
+// of a stopped machine.
+type SnapshotCreateOptions struct {
+	Name string
+}
+
+// SnapshotRestoreOptions names an existing snapshot to roll a stopped
+// machine's disk image back to.
+type SnapshotRestoreOptions struct {
+	Name string
 }
 
 type SSHOptions struct {
@@ -114,8 +176,16 @@ type RemoveOptions struct {
 }
 
 type VM interface {
+	AddPort(name string, opts PortAddOptions) error
+	AddVolume(name string, opts VolumeAddOptions) error
+	CreateSnapshot(name string, opts SnapshotCreateOptions) error
 	Init(opts InitOptions) (bool, error)
+	ListPorts(name string) ([]PortMapping, error)
+	ListSnapshots(name string) ([]string, error)
 	Remove(name string, opts RemoveOptions) (string, func() error, error)
+	RemovePort(name string, opts PortRemoveOptions) error
+	RemoveVolume(name string, opts VolumeRemoveOptions) error
+	RestoreSnapshot(name string, opts SnapshotRestoreOptions) error
 	Set(name string, opts SetOptions) error
 	SSH(name string, opts SSHOptions) error
 	Start(name string, opts StartOptions) error
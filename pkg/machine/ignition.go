@@ -54,6 +54,10 @@ type DynamicIgnition struct {
 	UID       int
 	VMName    string
 	WritePath string
+	// CloudInit is a path to a cloud-init user-data file. Its contents are
+	// embedded verbatim into the generated Ignition config so a guest
+	// image that runs cloud-init can pick it up on first boot.
+	CloudInit string
 }
 
 // NewIgnitionFile
@@ -85,6 +89,27 @@ func NewIgnitionFile(ign DynamicIgnition) error {
 		Links:       getLinks(ign.Name),
 	}
 
+	if len(ign.CloudInit) > 0 {
+		userData, err := ioutil.ReadFile(ign.CloudInit)
+		if err != nil {
+			return err
+		}
+		ignStorage.Files = append(ignStorage.Files, File{
+			Node: Node{
+				Group: getNodeGrp("root"),
+				Path:  "/var/lib/cloud-init/user-data",
+				User:  getNodeUsr("root"),
+			},
+			FileEmbedded1: FileEmbedded1{
+				Append: nil,
+				Contents: Resource{
+					Source: encodeDataURLPtr(string(userData)),
+				},
+				Mode: intToPtr(0644),
+			},
+		})
+	}
+
 	// Add or set the time zone for the machine
 	if len(ign.TimeZone) > 0 {
 		var (
@@ -182,6 +207,24 @@ ExecStart=/usr/bin/bash -c '/usr/bin/test -f ${FWCFGRAW} && (\
 ExecStartPost=/usr/bin/systemctl daemon-reload
 [Install]
 WantedBy=sysinit.target
+`
+	// This service installs additional CA certificates provided through
+	// qemu fw_cfg into the system trust store on every boot, so a
+	// per-machine CA can be refreshed with `podman machine start`
+	// instead of requiring the machine to be re-initialized.
+	caCertSet := `[Unit]
+Description=CA certificate installer from QEMU FW_CFG
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+Environment=FWCFGRAW=/sys/firmware/qemu_fw_cfg/by_name/opt/com.coreos/ca-certs/raw
+Environment=CA_CERT=/etc/pki/ca-trust/source/anchors/podman-machine-ca.crt
+ExecStart=/usr/bin/bash -c '/usr/bin/test -f ${FWCFGRAW} &&\
+	/usr/bin/base64 -d ${FWCFGRAW} > ${CA_CERT} ||\
+	/bin/rm -f ${CA_CERT}'
+ExecStartPost=/usr/bin/update-ca-trust extract
+[Install]
+WantedBy=sysinit.target
 `
 	_ = ready
 	ignSystemd := Systemd{
@@ -215,6 +258,11 @@ WantedBy=sysinit.target
 				Name:     "envset-fwcfg.service",
 				Contents: &envset,
 			},
+			{
+				Enabled:  boolToPtr(true),
+				Name:     "ca-cert-fwcfg.service",
+				Contents: &caCertSet,
+			},
 		}}
 	ignConfig := Config{
 		Ignition: ignVersion,
@@ -0,0 +1,17 @@
+//go:build (amd64 && !windows) || (arm64 && !windows)
+// +build amd64,!windows arm64,!windows
+
+package libvirt
+
+// Provider is a machine.Provider that manages machines through libvirt
+// (virsh, snapshots, existing virt tooling) instead of driving qemu
+// directly. It is a scaffold for that integration: NewMachine and friends
+// currently report that the backend isn't wired up yet, rather than
+// silently falling back to another provider.
+type Provider struct{}
+
+// MachineVM is the libvirt provider's machine.VM implementation. It has no
+// state of its own yet since machines can't be created through it.
+type MachineVM struct {
+	Name string
+}
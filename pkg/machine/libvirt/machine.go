@@ -0,0 +1,91 @@
+//go:build (amd64 && !windows) || (arm64 && !windows)
+// +build amd64,!windows arm64,!windows
+
+package libvirt
+
+import (
+	"github.com/containers/podman/v4/pkg/machine"
+	"github.com/pkg/errors"
+)
+
+var errNotImplemented = errors.New("the libvirt machine provider is not yet implemented; use the qemu provider instead")
+
+func GetLibvirtProvider() machine.Provider {
+	return &Provider{}
+}
+
+func (p *Provider) NewMachine(_ machine.InitOptions) (machine.VM, error) {
+	return nil, errNotImplemented
+}
+
+func (p *Provider) LoadVMByName(_ string) (machine.VM, error) {
+	return nil, errNotImplemented
+}
+
+func (p *Provider) List(_ machine.ListOptions) ([]*machine.ListResponse, error) {
+	return nil, errNotImplemented
+}
+
+func (p *Provider) IsValidVMName(_ string) (bool, error) {
+	return false, errNotImplemented
+}
+
+func (p *Provider) CheckExclusiveActiveVM() (bool, string, error) {
+	return false, "", errNotImplemented
+}
+
+func (v *MachineVM) AddPort(_ string, _ machine.PortAddOptions) error {
+	return errNotImplemented
+}
+
+func (v *MachineVM) AddVolume(_ string, _ machine.VolumeAddOptions) error {
+	return errNotImplemented
+}
+
+func (v *MachineVM) CreateSnapshot(_ string, _ machine.SnapshotCreateOptions) error {
+	return errNotImplemented
+}
+
+func (v *MachineVM) RestoreSnapshot(_ string, _ machine.SnapshotRestoreOptions) error {
+	return errNotImplemented
+}
+
+func (v *MachineVM) ListSnapshots(_ string) ([]string, error) {
+	return nil, errNotImplemented
+}
+
+func (v *MachineVM) Init(_ machine.InitOptions) (bool, error) {
+	return false, errNotImplemented
+}
+
+func (v *MachineVM) ListPorts(_ string) ([]machine.PortMapping, error) {
+	return nil, errNotImplemented
+}
+
+func (v *MachineVM) Remove(_ string, _ machine.RemoveOptions) (string, func() error, error) {
+	return "", nil, errNotImplemented
+}
+
+func (v *MachineVM) RemovePort(_ string, _ machine.PortRemoveOptions) error {
+	return errNotImplemented
+}
+
+func (v *MachineVM) RemoveVolume(_ string, _ machine.VolumeRemoveOptions) error {
+	return errNotImplemented
+}
+
+func (v *MachineVM) Set(_ string, _ machine.SetOptions) error {
+	return errNotImplemented
+}
+
+func (v *MachineVM) SSH(_ string, _ machine.SSHOptions) error {
+	return errNotImplemented
+}
+
+func (v *MachineVM) Start(_ string, _ machine.StartOptions) error {
+	return errNotImplemented
+}
+
+func (v *MachineVM) Stop(_ string, _ machine.StopOptions) error {
+	return errNotImplemented
+}
@@ -5,6 +5,8 @@ package qemu
 
 import (
 	"time"
+
+	"github.com/containers/podman/v4/pkg/machine"
 )
 
 type Provider struct{}
@@ -16,6 +18,11 @@ type MachineVM struct {
 	CmdLine []string
 	// Mounts is the list of remote filesystems to mount
 	Mounts []Mount
+	// PortMappings is the list of additional host-to-guest port forwards
+	PortMappings []machine.PortMapping
+	// CACertsPath is a path to a PEM file of additional CA certificates
+	// installed into the guest's trust store on every start
+	CACertsPath string
 	// IdentityPath is the fq path to the ssh priv key
 	IdentityPath string
 	// IgnitionFilePath is the fq path to the .ign file
@@ -161,6 +161,19 @@ func (p *Provider) LoadVMByName(name string) (machine.VM, error) {
 		vm.CmdLine = append(vm.CmdLine, "-fw_cfg", proxyStr)
 	}
 
+	// Likewise, re-read and re-inject any configured additional CA
+	// certificates on every start, so updating them doesn't require
+	// reinitializing the machine.
+	if vm.CACertsPath != "" {
+		caCerts, err := ioutil.ReadFile(vm.CACertsPath)
+		if err != nil {
+			logrus.Warnf("Unable to read CA certs from %q: %s", vm.CACertsPath, err.Error())
+		} else {
+			caCertStr := "name=opt/com.coreos/ca-certs,string=" + base64.StdEncoding.EncodeToString(caCerts)
+			vm.CmdLine = append(vm.CmdLine, "-fw_cfg", caCertStr)
+		}
+	}
+
 	logrus.Debug(vm.CmdLine)
 	return vm, err
 }
@@ -174,6 +187,7 @@ func (v *MachineVM) Init(opts machine.InitOptions) (bool, error) {
 	sshDir := filepath.Join(homedir.Get(), ".ssh")
 	v.IdentityPath = filepath.Join(sshDir, v.Name)
 	v.Rootful = opts.Rootful
+	v.CACertsPath = opts.CACertsPath
 
 	switch opts.ImagePath {
 	case "testing", "next", "stable", "":
@@ -204,50 +218,20 @@ func (v *MachineVM) Init(opts machine.InitOptions) (bool, error) {
 	// Add arch specific options including image location
 	v.CmdLine = append(v.CmdLine, v.addArchOptions()...)
 
-	var volumeType string
-	switch opts.VolumeDriver {
-	case "virtfs":
-		volumeType = VolumeTypeVirtfs
-	case "": // default driver
-		volumeType = VolumeTypeVirtfs
-	default:
-		err := fmt.Errorf("unknown volume driver: %s", opts.VolumeDriver)
+	defaultVolumeDriver, err := parseVolumeDriver(opts.VolumeDriver)
+	if err != nil {
 		return false, err
 	}
 
 	mounts := []Mount{}
 	for i, volume := range opts.Volumes {
 		tag := fmt.Sprintf("vol%d", i)
-		paths := strings.SplitN(volume, ":", 3)
-		source := paths[0]
-		target := source
-		readonly := false
-		if len(paths) > 1 {
-			target = paths[1]
-		}
-		if len(paths) > 2 {
-			options := paths[2]
-			volopts := strings.Split(options, ",")
-			for _, o := range volopts {
-				switch o {
-				case "rw":
-					readonly = false
-				case "ro":
-					readonly = true
-				default:
-					fmt.Printf("Unknown option: %s\n", o)
-				}
-			}
-		}
-		switch volumeType {
-		case VolumeTypeVirtfs:
-			virtfsOptions := fmt.Sprintf("local,path=%s,mount_tag=%s,security_model=mapped-xattr", source, tag)
-			if readonly {
-				virtfsOptions += ",readonly"
-			}
-			v.CmdLine = append(v.CmdLine, []string{"-virtfs", virtfsOptions}...)
-			mounts = append(mounts, Mount{Type: MountType9p, Tag: tag, Source: source, Target: target, ReadOnly: readonly})
+		mount, cmdArgs, err := buildMount(defaultVolumeDriver, tag, volume)
+		if err != nil {
+			return false, err
 		}
+		v.CmdLine = append(v.CmdLine, cmdArgs...)
+		mounts = append(mounts, mount)
 	}
 	v.Mounts = mounts
 	v.UID = os.Getuid()
@@ -296,30 +280,9 @@ func (v *MachineVM) Init(opts machine.InitOptions) (bool, error) {
 		return false, err
 	}
 
-	originalDiskSize, err := getDiskSize(v.ImagePath)
-	if err != nil {
+	if err := resizeDisk(v.ImagePath, opts.DiskSize); err != nil {
 		return false, err
 	}
-	// Resize the disk image to input disk size
-	// only if the virtualdisk size is less than
-	// the given disk size
-	if opts.DiskSize<<(10*3) > originalDiskSize {
-		// Find the qemu executable
-		cfg, err := config.Default()
-		if err != nil {
-			return false, err
-		}
-		resizePath, err := cfg.FindHelperBinary("qemu-img", true)
-		if err != nil {
-			return false, err
-		}
-		resize := exec.Command(resizePath, []string{"resize", v.ImagePath, strconv.Itoa(int(opts.DiskSize)) + "G"}...)
-		resize.Stdout = os.Stdout
-		resize.Stderr = os.Stderr
-		if err := resize.Run(); err != nil {
-			return false, errors.Errorf("error resizing image: %q", err)
-		}
-	}
 	// If the user provides an ignition file, we need to
 	// copy it into the conf dir
 	if len(opts.IgnitionPath) > 0 {
@@ -337,32 +300,356 @@ func (v *MachineVM) Init(opts machine.InitOptions) (bool, error) {
 		TimeZone:  opts.TimeZone,
 		WritePath: v.IgnitionFilePath,
 		UID:       v.UID,
+		CloudInit: opts.CloudInit,
 	}
 	err = machine.NewIgnitionFile(ign)
 	return err == nil, err
 }
 
-func (v *MachineVM) Set(name string, opts machine.SetOptions) error {
-	if v.Rootful == opts.Rootful {
-		return nil
+// parseVolumeDriver validates a volume driver name and returns its
+// canonical form, defaulting to virtfs (9p) when empty.
+func parseVolumeDriver(volumeDriver string) (string, error) {
+	switch volumeDriver {
+	case "virtfs", "9p", "":
+		return VolumeTypeVirtfs, nil
+	case "virtiofs":
+		return "", errors.New("virtiofs is not yet supported by the qemu machine provider; use 9p (the default) instead")
+	default:
+		return "", fmt.Errorf("unknown volume driver: %s", volumeDriver)
+	}
+}
+
+// buildMount parses a host:target[:opts] volume spec into a Mount and the
+// qemu command line arguments needed to expose it. An option token of "9p"
+// or "virtfs" overrides defaultDriver for this mount only; "virtiofs" is
+// rejected with an error.
+func buildMount(defaultDriver, tag, volume string) (Mount, []string, error) {
+	driver := defaultDriver
+	paths := strings.SplitN(volume, ":", 3)
+	source := paths[0]
+	target := source
+	readonly := false
+	if len(paths) > 1 {
+		target = paths[1]
+	}
+	if len(paths) > 2 {
+		for _, o := range strings.Split(paths[2], ",") {
+			switch o {
+			case "rw":
+				readonly = false
+			case "ro":
+				readonly = true
+			case "9p", "virtfs", "virtiofs":
+				d, err := parseVolumeDriver(o)
+				if err != nil {
+					return Mount{}, nil, err
+				}
+				driver = d
+			default:
+				fmt.Printf("Unknown option: %s\n", o)
+			}
+		}
+	}
+	switch driver {
+	case VolumeTypeVirtfs:
+		virtfsOptions := fmt.Sprintf("local,path=%s,mount_tag=%s,security_model=mapped-xattr", source, tag)
+		if readonly {
+			virtfsOptions += ",readonly"
+		}
+		return Mount{Type: MountType9p, Tag: tag, Source: source, Target: target, ReadOnly: readonly}, []string{"-virtfs", virtfsOptions}, nil
+	}
+	return Mount{}, nil, fmt.Errorf("unknown volume driver: %s", driver)
+}
+
+// nextMountTag returns a "volN" tag that is not already in use by mounts.
+func nextMountTag(mounts []Mount) string {
+	next := len(mounts)
+	for {
+		tag := fmt.Sprintf("vol%d", next)
+		found := false
+		for _, m := range mounts {
+			if m.Tag == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return tag
+		}
+		next++
 	}
+}
 
-	changeCon, err := machine.AnyConnectionDefault(v.Name, v.Name+"-root")
+// AddVolume mounts an additional host directory into a stopped machine.
+func (v *MachineVM) AddVolume(name string, opts machine.VolumeAddOptions) error {
+	running, err := v.isRunning()
 	if err != nil {
 		return err
 	}
+	if running {
+		return errors.Errorf("cannot add a volume while machine %q is running; stop it first", name)
+	}
 
-	if changeCon {
-		newDefault := v.Name
-		if opts.Rootful {
-			newDefault += "-root"
+	tag := nextMountTag(v.Mounts)
+	mount, cmdArgs, err := buildMount(VolumeTypeVirtfs, tag, opts.Volume)
+	if err != nil {
+		return err
+	}
+	v.CmdLine = append(v.CmdLine, cmdArgs...)
+	v.Mounts = append(v.Mounts, mount)
+	return v.writeConfig()
+}
+
+// RemoveVolume unmounts a previously added volume from a stopped machine.
+func (v *MachineVM) RemoveVolume(name string, opts machine.VolumeRemoveOptions) error {
+	running, err := v.isRunning()
+	if err != nil {
+		return err
+	}
+	if running {
+		return errors.Errorf("cannot remove a volume while machine %q is running; stop it first", name)
+	}
+
+	idx := -1
+	for i, m := range v.Mounts {
+		if m.Tag == opts.Tag {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return errors.Errorf("no volume with tag %q on machine %q", opts.Tag, name)
+	}
+
+	needle := "mount_tag=" + opts.Tag + ","
+	for i := 0; i < len(v.CmdLine)-1; i++ {
+		if v.CmdLine[i] == "-virtfs" && strings.Contains(v.CmdLine[i+1], needle) {
+			v.CmdLine = append(v.CmdLine[:i], v.CmdLine[i+2:]...)
+			break
+		}
+	}
+	v.Mounts = append(v.Mounts[:idx], v.Mounts[idx+1:]...)
+	return v.writeConfig()
+}
+
+// AddPort persists an additional host-to-guest port forward on a stopped
+// machine. The forward is set up by gvproxy and takes effect the next
+// time the machine is started.
+func (v *MachineVM) AddPort(name string, opts machine.PortAddOptions) error {
+	running, err := v.isRunning()
+	if err != nil {
+		return err
+	}
+	if running {
+		return errors.Errorf("cannot add a port forward while machine %q is running; stop it first", name)
+	}
+
+	mapping := opts.Mapping
+	if mapping.Protocol == "" {
+		mapping.Protocol = "tcp"
+	}
+	for _, m := range v.PortMappings {
+		if m.Protocol == mapping.Protocol && m.HostPort == mapping.HostPort {
+			return errors.Errorf("host port %d/%s is already forwarded on machine %q", mapping.HostPort, mapping.Protocol, name)
+		}
+	}
+
+	v.PortMappings = append(v.PortMappings, mapping)
+	return v.writeConfig()
+}
+
+// RemovePort drops a previously added port forward, or all of them when
+// opts.All is set, from a stopped machine.
+func (v *MachineVM) RemovePort(name string, opts machine.PortRemoveOptions) error {
+	running, err := v.isRunning()
+	if err != nil {
+		return err
+	}
+	if running {
+		return errors.Errorf("cannot remove a port forward while machine %q is running; stop it first", name)
+	}
+
+	if opts.All {
+		v.PortMappings = nil
+		return v.writeConfig()
+	}
+
+	protocol := opts.Mapping.Protocol
+	if protocol == "" {
+		protocol = "tcp"
+	}
+	idx := -1
+	for i, m := range v.PortMappings {
+		if m.Protocol == protocol && m.HostPort == opts.Mapping.HostPort {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return errors.Errorf("no port forward for host port %d/%s on machine %q", opts.Mapping.HostPort, protocol, name)
+	}
+	v.PortMappings = append(v.PortMappings[:idx], v.PortMappings[idx+1:]...)
+	return v.writeConfig()
+}
+
+// ListPorts returns the additional port forwards configured on the machine.
+func (v *MachineVM) ListPorts(name string) ([]machine.PortMapping, error) {
+	return v.PortMappings, nil
+}
+
+// CreateSnapshot takes an internal qcow2 snapshot of a stopped machine's
+// disk image, tagged opts.Name, that RestoreSnapshot can later roll back
+// to.
+func (v *MachineVM) CreateSnapshot(name string, opts machine.SnapshotCreateOptions) error {
+	running, err := v.isRunning()
+	if err != nil {
+		return err
+	}
+	if running {
+		return errors.Errorf("cannot snapshot machine %q while it is running; stop it first", name)
+	}
+	return runQemuImgSnapshot("-c", opts.Name, v.ImagePath)
+}
+
+// RestoreSnapshot rolls a stopped machine's disk image back to a snapshot
+// previously taken with CreateSnapshot. Any changes made since that
+// snapshot was created are lost.
+func (v *MachineVM) RestoreSnapshot(name string, opts machine.SnapshotRestoreOptions) error {
+	running, err := v.isRunning()
+	if err != nil {
+		return err
+	}
+	if running {
+		return errors.Errorf("cannot restore a snapshot on machine %q while it is running; stop it first", name)
+	}
+	return runQemuImgSnapshot("-a", opts.Name, v.ImagePath)
+}
+
+// ListSnapshots returns the tags of the internal disk-image snapshots
+// taken of the machine.
+func (v *MachineVM) ListSnapshots(name string) ([]string, error) {
+	cfg, err := config.Default()
+	if err != nil {
+		return nil, err
+	}
+	imgBinary, err := cfg.FindHelperBinary("qemu-img", true)
+	if err != nil {
+		return nil, err
+	}
+	out, err := exec.Command(imgBinary, "snapshot", "-l", v.ImagePath).Output()
+	if err != nil {
+		return nil, errors.Errorf("error listing snapshots: %q", err)
+	}
+	return parseQemuImgSnapshotList(string(out)), nil
+}
+
+// runQemuImgSnapshot runs `qemu-img snapshot <action> <tag> <imagePath>`,
+// where action is "-c" (create) or "-a" (apply/restore).
+func runQemuImgSnapshot(action, tag, imagePath string) error {
+	cfg, err := config.Default()
+	if err != nil {
+		return err
+	}
+	imgBinary, err := cfg.FindHelperBinary("qemu-img", true)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(imgBinary, "snapshot", action, tag, imagePath)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("error running qemu-img snapshot: %q", err)
+	}
+	return nil
+}
+
+// parseQemuImgSnapshotList extracts snapshot tags from the tabular output
+// of `qemu-img snapshot -l`, which looks like:
+//
+//	Snapshot list:
+//	ID        TAG                 VM SIZE                DATE       VM CLOCK
+//	1         clean-install          0 B 2023-01-01 00:00:00   00:00:00.000
+func parseQemuImgSnapshotList(out string) []string {
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) < 3 {
+		return nil
+	}
+	tags := make([]string, 0, len(lines)-2)
+	for _, line := range lines[2:] {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		tags = append(tags, fields[1])
+	}
+	return tags
+}
+
+func (v *MachineVM) Set(name string, opts machine.SetOptions) error {
+	if v.Rootful != opts.Rootful {
+		changeCon, err := machine.AnyConnectionDefault(v.Name, v.Name+"-root")
+		if err != nil {
+			return err
+		}
+
+		if changeCon {
+			newDefault := v.Name
+			if opts.Rootful {
+				newDefault += "-root"
+			}
+			if err := machine.ChangeDefault(newDefault); err != nil {
+				return err
+			}
+		}
+
+		v.Rootful = opts.Rootful
+
+		running, err := v.isRunning()
+		if err != nil {
+			return err
 		}
-		if err := machine.ChangeDefault(newDefault); err != nil {
+		if running {
+			// Both podman.socket units are already running inside the
+			// guest; restart just the API-forwarding process so it
+			// forwards to the socket for the new Rootful setting
+			// immediately, instead of requiring the machine to be
+			// restarted.
+			if err := v.restartHostNetworking(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if opts.CPUs > 0 || opts.Memory > 0 || opts.DiskSize > 0 {
+		running, err := v.isRunning()
+		if err != nil {
 			return err
 		}
+		if running {
+			return errors.Errorf("cannot resize a running machine %q; stop it first", name)
+		}
+	}
+
+	// cmdline is always built in NewMachine as [exe, -m, <mem>, -smp, <cpus>, ...],
+	// so those indices are stable to patch in place.
+	if opts.Memory > 0 {
+		v.Memory = opts.Memory
+		v.CmdLine[2] = strconv.FormatUint(v.Memory, 10)
+	}
+	if opts.CPUs > 0 {
+		v.CPUs = opts.CPUs
+		v.CmdLine[4] = strconv.FormatUint(v.CPUs, 10)
+	}
+	if opts.DiskSize > 0 {
+		if opts.DiskSize < v.DiskSize {
+			return errors.Errorf("new disk size %dGB cannot be smaller than existing size %dGB", opts.DiskSize, v.DiskSize)
+		}
+		if err := resizeDisk(v.ImagePath, opts.DiskSize); err != nil {
+			return err
+		}
+		v.DiskSize = opts.DiskSize
 	}
 
-	v.Rootful = opts.Rootful
 	return v.writeConfig()
 }
 
@@ -828,6 +1115,33 @@ func (v *MachineVM) SSH(name string, opts machine.SSHOptions) error {
 
 // executes qemu-image info to get the virtual disk size
 // of the diskimage
+// resizeDisk grows imagePath to newSizeGB, if newSizeGB is larger than the
+// image's current size. Shrinking a disk image is not supported.
+func resizeDisk(imagePath string, newSizeGB uint64) error {
+	originalDiskSize, err := getDiskSize(imagePath)
+	if err != nil {
+		return err
+	}
+	if newSizeGB<<(10*3) <= originalDiskSize {
+		return nil
+	}
+	cfg, err := config.Default()
+	if err != nil {
+		return err
+	}
+	resizePath, err := cfg.FindHelperBinary("qemu-img", true)
+	if err != nil {
+		return err
+	}
+	resize := exec.Command(resizePath, []string{"resize", imagePath, strconv.Itoa(int(newSizeGB)) + "G"}...)
+	resize.Stdout = os.Stdout
+	resize.Stderr = os.Stderr
+	if err := resize.Run(); err != nil {
+		return errors.Errorf("error resizing image: %q", err)
+	}
+	return nil
+}
+
 func getDiskSize(path string) (uint64, error) {
 	// Find the qemu executable
 	cfg, err := config.Default()
@@ -958,6 +1272,32 @@ func (p *Provider) CheckExclusiveActiveVM() (bool, string, error) {
 	return false, "", nil
 }
 
+// restartHostNetworking kills and re-launches the API-forwarding process
+// (gvproxy) against a running machine, picking up any change to v.Rootful
+// (or the port forwards below) without requiring the VM itself to be
+// restarted.
+func (v *MachineVM) restartHostNetworking() error {
+	_, pidFile, err := v.getSocketandPid()
+	if err != nil {
+		return err
+	}
+	if pidBytes, err := ioutil.ReadFile(pidFile); err == nil {
+		if pid, err := strconv.Atoi(strings.TrimSpace(string(pidBytes))); err == nil {
+			if p, err := os.FindProcess(pid); err == nil {
+				_ = p.Kill()
+			}
+		}
+		_ = os.Remove(pidFile)
+	}
+
+	forwardSock, state, err := v.startHostNetworking()
+	if err != nil {
+		return err
+	}
+	v.waitAPIAndPrintInfo(state, forwardSock)
+	return nil
+}
+
 // startHostNetworking runs a binary on the host system that allows users
 // to setup port forwarding to the podman virtual machine
 func (v *MachineVM) startHostNetworking() (string, apiForwardingState, error) {
@@ -983,6 +1323,8 @@ func (v *MachineVM) startHostNetworking() (string, apiForwardingState, error) {
 	// Add the ssh port
 	cmd = append(cmd, []string{"-ssh-port", fmt.Sprintf("%d", v.Port)}...)
 
+	cmd = append(cmd, portMappingArgs(v.PortMappings)...)
+
 	var forwardSock string
 	var state apiForwardingState
 	if !v.isIncompatible() {
@@ -997,6 +1339,25 @@ func (v *MachineVM) startHostNetworking() (string, apiForwardingState, error) {
 	return forwardSock, state, err
 }
 
+// portMappingArgs turns the machine's persisted port forwards into
+// "-publish-ports" arguments for gvproxy, in host_ip:host_port:guest_port/proto
+// form.
+func portMappingArgs(mappings []machine.PortMapping) []string {
+	if len(mappings) == 0 {
+		return nil
+	}
+	specs := make([]string, 0, len(mappings))
+	for _, m := range mappings {
+		hostIP := m.HostIP
+		proto := m.Protocol
+		if proto == "" {
+			proto = "tcp"
+		}
+		specs = append(specs, fmt.Sprintf("%s:%d:%d/%s", hostIP, m.HostPort, m.GuestPort, proto))
+	}
+	return []string{"-publish-ports", strings.Join(specs, ",")}
+}
+
 func (v *MachineVM) setupAPIForwarding(cmd []string) ([]string, string, apiForwardingState) {
 	socket, err := v.getForwardSocketPath()
 
@@ -728,7 +728,51 @@ func pipeCmdPassThrough(name string, input string, arg ...string) error {
 	return cmd.Run()
 }
 
+// AddVolume is not supported by the WSL machine provider.
+func (v *MachineVM) AddVolume(name string, opts machine.VolumeAddOptions) error {
+	return errors.New("adding volumes to a machine is not supported for WSL machines")
+}
+
+// RemoveVolume is not supported by the WSL machine provider.
+func (v *MachineVM) RemoveVolume(name string, opts machine.VolumeRemoveOptions) error {
+	return errors.New("removing volumes from a machine is not supported for WSL machines")
+}
+
+// AddPort is not supported by the WSL machine provider.
+func (v *MachineVM) AddPort(name string, opts machine.PortAddOptions) error {
+	return errors.New("adding port forwards is not supported for WSL machines")
+}
+
+// RemovePort is not supported by the WSL machine provider.
+func (v *MachineVM) RemovePort(name string, opts machine.PortRemoveOptions) error {
+	return errors.New("removing port forwards is not supported for WSL machines")
+}
+
+// ListPorts is not supported by the WSL machine provider.
+func (v *MachineVM) ListPorts(name string) ([]machine.PortMapping, error) {
+	return nil, errors.New("listing port forwards is not supported for WSL machines")
+}
+
+// CreateSnapshot is not supported by the WSL machine provider.
+func (v *MachineVM) CreateSnapshot(name string, opts machine.SnapshotCreateOptions) error {
+	return errors.New("snapshots are not supported for WSL machines")
+}
+
+// RestoreSnapshot is not supported by the WSL machine provider.
+func (v *MachineVM) RestoreSnapshot(name string, opts machine.SnapshotRestoreOptions) error {
+	return errors.New("snapshots are not supported for WSL machines")
+}
+
+// ListSnapshots is not supported by the WSL machine provider.
+func (v *MachineVM) ListSnapshots(name string) ([]string, error) {
+	return nil, errors.New("snapshots are not supported for WSL machines")
+}
+
 func (v *MachineVM) Set(name string, opts machine.SetOptions) error {
+	if opts.CPUs > 0 || opts.Memory > 0 || opts.DiskSize > 0 {
+		return errors.New("resizing CPUs, memory or disk size is not yet supported for WSL machines")
+	}
+
 	if v.Rootful == opts.Rootful {
 		return nil
 	}
@@ -25,7 +25,7 @@ func GetContainerLists(runtime *libpod.Runtime, options entities.ContainerListOp
 		pss = []entities.ListContainer{}
 	)
 	filterFuncs := make([]libpod.ContainerFilter, 0, len(options.Filters))
-	all := options.All || options.Last > 0
+	all := options.All || options.Last > 0 || options.Offset > 0
 	if len(options.Filters) > 0 {
 		for k, v := range options.Filters {
 			generatedFunc, err := filters.GenerateContainerFilterFuncs(k, v, runtime)
@@ -53,24 +53,66 @@ func GetContainerLists(runtime *libpod.Runtime, options entities.ContainerListOp
 	if err != nil {
 		return nil, err
 	}
-	if options.Last > 0 {
+	if options.Last > 0 || options.Offset > 0 {
 		// Sort the libpod containers
 		sort.Sort(SortCreateTime{SortContainers: cons})
-		// we should perform the lopping before we start getting
-		// the expensive information on containers
-		if options.Last < len(cons) {
-			cons = cons[:options.Last]
+		// we should perform the lopping before we start getting the
+		// expensive information on containers.  The final offset is
+		// re-applied below once External containers, if any, have
+		// been merged in, so only pre-trim the tail here.
+		if options.Last > 0 {
+			if window := options.Offset + options.Last; window < len(cons) {
+				cons = cons[:window]
+			}
 		}
 	}
-	for _, con := range cons {
-		listCon, err := ListContainerBatch(runtime, con, options)
-		switch {
-		case errors.Cause(err) == define.ErrNoSuchCtr:
-			continue
-		case err != nil:
+	if options.Quick {
+		summaries, err := runtime.GetContainerSummaries(false)
+		if err != nil {
 			return nil, err
-		default:
-			pss = append(pss, listCon)
+		}
+		summariesByID := make(map[string]define.ContainerSummary, len(summaries))
+		for _, summary := range summaries {
+			summariesByID[summary.ID] = summary
+		}
+
+		for _, con := range cons {
+			summary, ok := summariesByID[con.ID()]
+			if !ok {
+				// Summary table is missing an entry, most likely because it
+				// predates this feature. Fall back to the authoritative,
+				// more expensive lookup rather than dropping the container.
+				listCon, err := ListContainerBatch(runtime, con, options)
+				switch {
+				case errors.Cause(err) == define.ErrNoSuchCtr:
+					continue
+				case err != nil:
+					return nil, err
+				default:
+					pss = append(pss, listCon)
+				}
+				continue
+			}
+			pss = append(pss, entities.ListContainer{
+				ID:     summary.ID,
+				Names:  []string{summary.Name},
+				Image:  summary.Image,
+				Labels: summary.Labels,
+				Ports:  summary.Ports,
+				State:  summary.State,
+			})
+		}
+	} else {
+		for _, con := range cons {
+			listCon, err := ListContainerBatch(runtime, con, options)
+			switch {
+			case errors.Cause(err) == define.ErrNoSuchCtr:
+				continue
+			case err != nil:
+				return nil, err
+			default:
+				pss = append(pss, listCon)
+			}
 		}
 	}
 
@@ -85,11 +127,19 @@ func GetContainerLists(runtime *libpod.Runtime, options entities.ContainerListOp
 	// Sort the containers we got
 	sort.Sort(SortPSCreateTime{SortPSContainers: pss})
 
-	if options.Last > 0 {
-		// only return the "last" containers caller requested
-		if options.Last < len(pss) {
+	if options.Last > 0 || options.Offset > 0 {
+		// only return the page of containers the caller requested,
+		// counted from the most recently created container
+		sort.Sort(sort.Reverse(SortPSCreateTime{SortPSContainers: pss}))
+		if options.Offset < len(pss) {
+			pss = pss[options.Offset:]
+		} else {
+			pss = []entities.ListContainer{}
+		}
+		if options.Last > 0 && options.Last < len(pss) {
 			pss = pss[:options.Last]
 		}
+		sort.Sort(SortPSCreateTime{SortPSContainers: pss})
 	}
 	return pss, nil
 }
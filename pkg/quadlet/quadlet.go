@@ -0,0 +1,121 @@
+// Package quadlet implements a small, declarative subset of what a full
+// systemd/quadlet integration would eventually support: reading
+// Podman-flavored ".container" unit files and turning them into container
+// definitions that podman can create and manage. It does not yet support
+// ".volume", ".network" or ".kube" units.
+package quadlet
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ContainerUnit represents the [Container] section of a ".container" unit
+// file.
+type ContainerUnit struct {
+	// Path is the location the unit was loaded from.
+	Path string
+	// Image is the container image to run. Required.
+	Image string
+	// Exec overrides the image's entrypoint/command.
+	Exec []string
+	// PublishPort is a list of "host:container" or "container" port
+	// mappings, passed through verbatim to --publish.
+	PublishPort []string
+	// Volume is a list of volume mappings, passed through verbatim to
+	// --volume.
+	Volume []string
+	// Environment is a list of "KEY=VALUE" pairs.
+	Environment []string
+	// ContainerName overrides the generated container name. If empty, a
+	// name is derived from the unit file name.
+	ContainerName string
+}
+
+// UnitName returns the name of the unit, derived from its file name without
+// the ".container" suffix.
+func (u *ContainerUnit) UnitName() string {
+	base := u.Path[strings.LastIndex(u.Path, "/")+1:]
+	return strings.TrimSuffix(base, ".container")
+}
+
+// LoadContainerUnit parses a ".container" unit file at path. Only the
+// [Container] section is understood; unknown sections and keys are ignored
+// so a single file can eventually grow [Unit]/[Service]/[Install] sections
+// without breaking this parser.
+func LoadContainerUnit(path string) (*ContainerUnit, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	unit := &ContainerUnit{Path: path}
+	section := ""
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSuffix(strings.TrimPrefix(line, "["), "]")
+			continue
+		}
+		if section != "Container" {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+		switch key {
+		case "Image":
+			unit.Image = value
+		case "Exec":
+			unit.Exec = strings.Fields(value)
+		case "PublishPort":
+			unit.PublishPort = append(unit.PublishPort, value)
+		case "Volume":
+			unit.Volume = append(unit.Volume, value)
+		case "Environment":
+			unit.Environment = append(unit.Environment, value)
+		case "ContainerName":
+			unit.ContainerName = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if unit.Image == "" {
+		return nil, errors.Errorf("%s: [Container] section is missing required Image= key", path)
+	}
+	return unit, nil
+}
+
+// LoadContainerUnitsFromDir loads every ".container" file found directly
+// inside dir (non-recursively, matching systemd's own unit search
+// semantics for a single directory).
+func LoadContainerUnitsFromDir(dir string) ([]*ContainerUnit, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var units []*ContainerUnit
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".container") {
+			continue
+		}
+		unit, err := LoadContainerUnit(dir + "/" + entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		units = append(units, unit)
+	}
+	return units, nil
+}
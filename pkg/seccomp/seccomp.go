@@ -10,6 +10,11 @@ import (
 // profile.
 const ContainerImageLabel = "io.containers.seccomp.profile"
 
+// TraceAnnotation is the annotation recognized by the oci-seccomp-bpf-hook
+// OCI hook. When present, the hook records the syscalls the container makes
+// and writes a generated seccomp profile to the path following "of:".
+const TraceAnnotation = "io.containers.trace-syscall"
+
 // Policy denotes a seccomp policy.
 type Policy int
 
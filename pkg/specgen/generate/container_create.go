@@ -58,6 +58,10 @@ func MakeContainer(ctx context.Context, rt *libpod.Runtime, s *specgen.SpecGener
 	if err := FinishThrottleDevices(s); err != nil {
 		return nil, nil, nil, err
 	}
+
+	if err := resolveCPUAffinity(rt, s); err != nil {
+		return nil, nil, nil, err
+	}
 	// Set defaults for unset namespaces
 	if s.PidNS.IsDefault() {
 		defaultNS, err := GetDefaultNamespaceMode("pid", rtc, pod)
@@ -138,6 +142,10 @@ func MakeContainer(ctx context.Context, rt *libpod.Runtime, s *specgen.SpecGener
 		return nil, nil, nil, errors.Wrap(err, "invalid config provided")
 	}
 
+	if pod != nil {
+		addPodResources(s, pod)
+	}
+
 	finalMounts, finalVolumes, finalOverlays, err := finalizeMounts(ctx, s, rt, rtc, newImage)
 	if err != nil {
 		return nil, nil, nil, err
@@ -227,6 +235,53 @@ func MakeContainer(ctx context.Context, rt *libpod.Runtime, s *specgen.SpecGener
 	}
 	return runtimeSpec, s, options, err
 }
+
+// addPodResources adds the pod's volumes and secrets to the container being
+// generated, unless the container already has its own mount or secret at the
+// same destination/target, in which case the container's own setting wins.
+func addPodResources(s *specgen.SpecGenerator, pod *libpod.Pod) {
+	usedDestinations := make(map[string]bool)
+	for _, m := range s.Mounts {
+		usedDestinations[filepath.Clean(m.Destination)] = true
+	}
+	for _, v := range s.Volumes {
+		usedDestinations[filepath.Clean(v.Dest)] = true
+	}
+	for _, v := range s.OverlayVolumes {
+		usedDestinations[filepath.Clean(v.Destination)] = true
+	}
+	for _, v := range s.ImageVolumes {
+		usedDestinations[filepath.Clean(v.Destination)] = true
+	}
+	for _, v := range pod.Volumes() {
+		if usedDestinations[filepath.Clean(v.Dest)] {
+			continue
+		}
+		s.Volumes = append(s.Volumes, &specgen.NamedVolume{
+			Name:    v.Name,
+			Dest:    v.Dest,
+			Options: v.Options,
+		})
+	}
+
+	usedTargets := make(map[string]bool)
+	for _, secr := range s.Secrets {
+		usedTargets[secr.Target] = true
+	}
+	for _, secr := range pod.Secrets() {
+		if usedTargets[secr.Target] {
+			continue
+		}
+		s.Secrets = append(s.Secrets, specgen.Secret{
+			Source: secr.Secret.Name,
+			Target: secr.Target,
+			UID:    secr.UID,
+			GID:    secr.GID,
+			Mode:   secr.Mode,
+		})
+	}
+}
+
 func ExecuteCreate(ctx context.Context, rt *libpod.Runtime, runtimeSpec *spec.Spec, s *specgen.SpecGenerator, infra bool, options ...libpod.CtrCreateOption) (*libpod.Container, error) {
 	ctr, err := rt.NewContainer(ctx, runtimeSpec, s, infra, options...)
 	if err != nil {
@@ -432,6 +487,9 @@ func createContainerOptions(ctx context.Context, rt *libpod.Runtime, s *specgen.
 		if len(s.LogConfiguration.Driver) > 0 {
 			options = append(options, libpod.WithLogDriver(s.LogConfiguration.Driver))
 		}
+		if fwdDriver := s.LogConfiguration.Options["forward-driver"]; fwdDriver != "" {
+			options = append(options, libpod.WithLogForwarding(fwdDriver, s.LogConfiguration.Options["forward-address"]))
+		}
 	}
 	// Security options
 	if len(s.SelinuxOpts) > 0 {
@@ -480,6 +538,10 @@ func createContainerOptions(ctx context.Context, rt *libpod.Runtime, s *specgen.
 		options = append(options, libpod.WithRestartPolicy(s.RestartPolicy))
 	}
 
+	if s.CheckpointOnStop {
+		options = append(options, libpod.WithCheckpointOnStop())
+	}
+
 	if s.ContainerHealthCheckConfig.HealthConfig != nil {
 		options = append(options, libpod.WithHealthCheck(s.ContainerHealthCheckConfig.HealthConfig))
 		logrus.Debugf("New container has a health check")
@@ -0,0 +1,90 @@
+package generate
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman/v4/libpod"
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/pkg/specgen"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// resolveCPUAffinity turns a "--cpu-affinity=auto" request into a concrete
+// cpuset. It pins the container to whichever host core is presently used by
+// the fewest other running containers, spreading containers evenly across
+// cores. It is a no-op unless CPUAffinity was requested.
+func resolveCPUAffinity(rt *libpod.Runtime, s *specgen.SpecGenerator) error {
+	if s.CPUAffinity == "" {
+		return nil
+	}
+
+	numCPUs := runtime.NumCPU()
+	usage := make([]int, numCPUs)
+
+	ctrs, err := rt.GetAllContainers()
+	if err != nil {
+		return err
+	}
+	for _, ctr := range ctrs {
+		state, err := ctr.State()
+		if err != nil || state != define.ContainerStateRunning {
+			continue
+		}
+		ctrSpec := ctr.Spec()
+		if ctrSpec == nil || ctrSpec.Linux == nil || ctrSpec.Linux.Resources == nil || ctrSpec.Linux.Resources.CPU == nil {
+			continue
+		}
+		for _, cpu := range parseCPUSet(ctrSpec.Linux.Resources.CPU.Cpus) {
+			if cpu >= 0 && cpu < numCPUs {
+				usage[cpu]++
+			}
+		}
+	}
+
+	chosen := 0
+	for cpu := 1; cpu < numCPUs; cpu++ {
+		if usage[cpu] < usage[chosen] {
+			chosen = cpu
+		}
+	}
+
+	if s.ResourceLimits == nil {
+		s.ResourceLimits = &spec.LinuxResources{}
+	}
+	if s.ResourceLimits.CPU == nil {
+		s.ResourceLimits.CPU = &spec.LinuxCPU{}
+	}
+	s.ResourceLimits.CPU.Cpus = strconv.Itoa(chosen)
+	return nil
+}
+
+// parseCPUSet expands a cpuset string such as "0-2,5" into the individual
+// CPU numbers it selects. Malformed entries are skipped rather than treated
+// as an error, since this is only used for best-effort spreading of
+// automatically pinned containers across cores.
+func parseCPUSet(cpuset string) []int {
+	var cpus []int
+	for _, part := range strings.Split(cpuset, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if rangeParts := strings.SplitN(part, "-", 2); len(rangeParts) == 2 {
+			lo, err1 := strconv.Atoi(strings.TrimSpace(rangeParts[0]))
+			hi, err2 := strconv.Atoi(strings.TrimSpace(rangeParts[1]))
+			if err1 != nil || err2 != nil || lo > hi {
+				continue
+			}
+			for cpu := lo; cpu <= hi; cpu++ {
+				cpus = append(cpus, cpu)
+			}
+			continue
+		}
+		if cpu, err := strconv.Atoi(part); err == nil {
+			cpus = append(cpus, cpu)
+		}
+	}
+	return cpus
+}
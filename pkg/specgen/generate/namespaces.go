@@ -29,8 +29,20 @@ func GetDefaultNamespaceMode(nsType string, cfg *config.Config, pod *libpod.Pod)
 	// Ensure case insensitivity
 	nsType = strings.ToLower(nsType)
 
-	// If the pod is not nil - check shared namespaces
-	if pod != nil && pod.HasInfraContainer() {
+	// If the pod is not nil - check shared namespaces. This also covers
+	// NoInfra pods once the first member has claimed the shared
+	// namespaces (see Pod.InfraContainerID) - until then, InfraContainerID
+	// is empty and the container being generated here is that first
+	// member, so it must fall through to its own private namespace below.
+	sharedNSOwnerExists := false
+	if pod != nil {
+		infraID, err := pod.InfraContainerID()
+		if err != nil {
+			return toReturn, err
+		}
+		sharedNSOwnerExists = pod.HasInfraContainer() || infraID != ""
+	}
+	if pod != nil && sharedNSOwnerExists {
 		podMode := false
 		switch {
 		case nsType == "pid" && pod.SharesPID():
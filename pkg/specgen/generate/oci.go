@@ -398,9 +398,15 @@ func SpecGenToOCI(ctx context.Context, s *specgen.SpecGenerator, rt *libpod.Runt
 	}
 	configSpec := g.Config
 
-	if err := securityConfigureGenerator(s, &g, newImage, rtc); err != nil {
+	if err := securityConfigureGenerator(s, &g, newImage, rt, rtc); err != nil {
 		return nil, err
 	}
+	// setupApparmor may have resolved a profile file into the name it
+	// declares; make sure the annotation podman inspect reads reflects
+	// that resolved name rather than the path the user passed in.
+	if _, ok := configSpec.Annotations[define.InspectAnnotationApparmor]; ok {
+		configSpec.Annotations[define.InspectAnnotationApparmor] = s.ApparmorProfile
+	}
 
 	// BIND MOUNTS
 	configSpec.Mounts = SupersedeUserMounts(mounts, configSpec.Mounts)
@@ -13,6 +13,7 @@ import (
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/containers/podman/v4/pkg/specgen"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -161,6 +162,24 @@ func MakePod(p *entities.PodSpec, rt *libpod.Runtime) (*libpod.Pod, error) {
 	return pod, nil
 }
 
+// podResourceLimitsSet reports whether resources contains any limit worth
+// enforcing on the pod's own Cgroup, as opposed to being an empty struct
+// left over from unconditional allocation upstream.
+func podResourceLimitsSet(resources *spec.LinuxResources) bool {
+	if resources.Memory != nil && (resources.Memory.Limit != nil || resources.Memory.Reservation != nil) {
+		return true
+	}
+	if resources.Pids != nil && resources.Pids.Limit != 0 {
+		return true
+	}
+	if cpu := resources.CPU; cpu != nil {
+		if cpu.Period != nil || cpu.Quota != nil || cpu.Cpus != "" {
+			return true
+		}
+	}
+	return false
+}
+
 func createPodOptions(p *specgen.PodSpecGenerator, rt *libpod.Runtime, infraSpec *specgen.SpecGenerator) ([]libpod.PodCreateOption, error) {
 	var (
 		options []libpod.PodCreateOption
@@ -170,19 +189,68 @@ func createPodOptions(p *specgen.PodSpecGenerator, rt *libpod.Runtime, infraSpec
 		if p.ShareParent == nil || (p.ShareParent != nil && *p.ShareParent) {
 			options = append(options, libpod.WithPodParent())
 		}
-		nsOptions, err := GetNamespaceOptions(p.SharedNamespaces, p.InfraContainerSpec.NetNS.IsHost())
-		if err != nil {
-			return nil, err
-		}
-		options = append(options, nsOptions...)
 		// Use pod user and infra userns only when --userns is not set to host
 		if !p.InfraContainerSpec.UserNS.IsHost() && !p.InfraContainerSpec.UserNS.IsDefault() {
 			options = append(options, libpod.WithPodUser())
 		}
 	}
+	// Namespace sharing is also allowed without an infra container: the
+	// first container joined to the pod claims ownership of the shared
+	// namespaces, and later members join it the same way they would join
+	// an infra container. Validate() has already ensured "net" is not
+	// among SharedNamespaces in that case.
+	if !p.NoInfra || len(p.SharedNamespaces) > 0 {
+		netnsIsHost := !p.NoInfra && p.InfraContainerSpec.NetNS.IsHost()
+		nsOptions, err := GetNamespaceOptions(p.SharedNamespaces, netnsIsHost)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, nsOptions...)
+	}
 	if len(p.CgroupParent) > 0 {
 		options = append(options, libpod.WithPodCgroupParent(p.CgroupParent))
 	}
+	if p.ResourceLimits != nil && podResourceLimitsSet(p.ResourceLimits) {
+		options = append(options, libpod.WithPodResources(*p.ResourceLimits))
+	}
+	if p.RestartPolicy != "" {
+		options = append(options, libpod.WithPodRestartPolicy(p.RestartPolicy))
+	}
+	if p.RestartRetries != nil {
+		options = append(options, libpod.WithPodRestartRetries(*p.RestartRetries))
+	}
+	if len(p.Volumes) > 0 {
+		var vols []*libpod.ContainerNamedVolume
+		for _, v := range p.Volumes {
+			vols = append(vols, &libpod.ContainerNamedVolume{
+				Name:    v.Name,
+				Dest:    v.Dest,
+				Options: v.Options,
+			})
+		}
+		options = append(options, libpod.WithPodVolumes(vols))
+	}
+	if len(p.Secrets) > 0 {
+		manager, err := rt.SecretsManager()
+		if err != nil {
+			return nil, err
+		}
+		var secrs []*libpod.ContainerSecret
+		for _, s := range p.Secrets {
+			secr, err := manager.Lookup(s.Source)
+			if err != nil {
+				return nil, err
+			}
+			secrs = append(secrs, &libpod.ContainerSecret{
+				Secret: secr,
+				UID:    s.UID,
+				GID:    s.GID,
+				Mode:   s.Mode,
+				Target: s.Target,
+			})
+		}
+		options = append(options, libpod.WithPodSecrets(secrs))
+	}
 	if len(p.Labels) > 0 {
 		options = append(options, libpod.WithPodLabels(p.Labels))
 	}
@@ -282,6 +350,13 @@ func MapSpec(p *specgen.PodSpecGenerator) (*specgen.SpecGenerator, error) {
 		p.InfraContainerSpec.ConmonPidFile = p.InfraConmonPidFile
 	}
 
+	if p.RestartPolicy != "" {
+		p.InfraContainerSpec.RestartPolicy = p.RestartPolicy
+	}
+	if p.RestartRetries != nil {
+		p.InfraContainerSpec.RestartRetries = p.RestartRetries
+	}
+
 	p.InfraContainerSpec.Image = p.InfraImage
 	return p.InfraContainerSpec, nil
 }
@@ -1,6 +1,7 @@
 package generate
 
 import (
+	"os"
 	"strings"
 
 	"github.com/containers/common/libimage"
@@ -9,6 +10,7 @@ import (
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/podman/v4/libpod"
 	"github.com/containers/podman/v4/libpod/define"
+	podmanApparmor "github.com/containers/podman/v4/pkg/apparmor"
 	"github.com/containers/podman/v4/pkg/specgen"
 	"github.com/containers/podman/v4/pkg/util"
 	"github.com/opencontainers/runtime-tools/generate"
@@ -58,7 +60,7 @@ func setLabelOpts(s *specgen.SpecGenerator, runtime *libpod.Runtime, pidConfig s
 	return nil
 }
 
-func setupApparmor(s *specgen.SpecGenerator, rtc *config.Config, g *generate.Generator) error {
+func setupApparmor(s *specgen.SpecGenerator, runtime *libpod.Runtime, rtc *config.Config, g *generate.Generator) error {
 	hasProfile := len(s.ApparmorProfile) > 0
 	if !apparmor.IsEnabled() {
 		if hasProfile && s.ApparmorProfile != "unconfined" {
@@ -73,6 +75,31 @@ func setupApparmor(s *specgen.SpecGenerator, rtc *config.Config, g *generate.Gen
 	if !hasProfile {
 		s.ApparmorProfile = rtc.Containers.ApparmorProfile
 	}
+	// If the caller passed a profile file rather than the name of an
+	// already-loaded profile, load it into the kernel ourselves and swap
+	// in the name it declares, so it can be referenced like any other
+	// profile from here on.
+	if len(s.ApparmorProfile) > 0 && s.ApparmorProfile != "unconfined" {
+		if info, err := os.Stat(s.ApparmorProfile); err == nil && !info.IsDir() {
+			profilePath := s.ApparmorProfile
+			name, err := podmanApparmor.LoadProfileFromFile(profilePath)
+			if err != nil {
+				return errors.Wrapf(err, "loading AppArmor profile %q", profilePath)
+			}
+			tmpDir, err := runtime.TmpDir()
+			if err != nil {
+				return err
+			}
+			registry, err := podmanApparmor.NewRegistry(tmpDir)
+			if err != nil {
+				return err
+			}
+			if err := registry.Add(name, profilePath); err != nil {
+				return err
+			}
+			s.ApparmorProfile = name
+		}
+	}
 	if len(s.ApparmorProfile) > 0 {
 		g.SetProcessApparmorProfile(s.ApparmorProfile)
 	}
@@ -80,7 +107,7 @@ func setupApparmor(s *specgen.SpecGenerator, rtc *config.Config, g *generate.Gen
 	return nil
 }
 
-func securityConfigureGenerator(s *specgen.SpecGenerator, g *generate.Generator, newImage *libimage.Image, rtc *config.Config) error {
+func securityConfigureGenerator(s *specgen.SpecGenerator, g *generate.Generator, newImage *libimage.Image, runtime *libpod.Runtime, rtc *config.Config) error {
 	var (
 		caplist []string
 		err     error
@@ -186,7 +213,7 @@ func securityConfigureGenerator(s *specgen.SpecGenerator, g *generate.Generator,
 
 	g.SetProcessNoNewPrivileges(s.NoNewPrivileges)
 
-	if err := setupApparmor(s, rtc, g); err != nil {
+	if err := setupApparmor(s, runtime, rtc, g); err != nil {
 		return err
 	}
 
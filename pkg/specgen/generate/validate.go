@@ -6,6 +6,7 @@ import (
 
 	"github.com/containers/common/pkg/cgroups"
 	"github.com/containers/common/pkg/sysinfo"
+	"github.com/containers/podman/v4/pkg/rootless"
 	"github.com/containers/podman/v4/pkg/specgen"
 	"github.com/containers/podman/v4/utils"
 	"github.com/pkg/errors"
@@ -184,6 +185,34 @@ func verifyContainerResourcesCgroupV2(s *specgen.SpecGenerator) ([]string, error
 			s.ResourceLimits.Memory.Swap = nil
 		}
 	}
+
+	if s.ResourceLimits.BlockIO != nil {
+		blkio := s.ResourceLimits.BlockIO
+		if blkio.Weight != nil && (*blkio.Weight > 10000 || *blkio.Weight < 1) {
+			return warnings, errors.New("range of blkio weight is from 1 to 10000")
+		}
+		hasIOLimits := blkio.Weight != nil || len(blkio.WeightDevice) > 0 ||
+			len(blkio.ThrottleReadBpsDevice) > 0 || len(blkio.ThrottleWriteBpsDevice) > 0 ||
+			len(blkio.ThrottleReadIOPSDevice) > 0 || len(blkio.ThrottleWriteIOPSDevice) > 0
+		if hasIOLimits && rootless.IsRootless() {
+			controllers, err := cgroups.GetAvailableControllers(nil, true)
+			if err != nil {
+				return warnings, err
+			}
+			delegated := false
+			for _, controller := range controllers {
+				if controller == "io" {
+					delegated = true
+					break
+				}
+			}
+			if !delegated {
+				warnings = append(warnings, "Cannot set io limits without the io controller being delegated to the rootless user; see podman-run(1) 'io' section for how to enable it. Block I/O limits discarded.")
+				s.ResourceLimits.BlockIO = nil
+			}
+		}
+	}
+
 	return warnings, nil
 }
 
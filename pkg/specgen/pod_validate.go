@@ -29,8 +29,13 @@ func (p *PodSpecGenerator) Validate() error {
 		if len(p.InfraName) > 0 {
 			return exclusivePodOptions("NoInfra", "InfraName")
 		}
-		if len(p.SharedNamespaces) > 0 {
-			return exclusivePodOptions("NoInfra", "SharedNamespaces")
+		for _, ns := range p.SharedNamespaces {
+			// Without an infra container, there is no container to
+			// configure networking on ahead of the first member joining
+			// the pod, so the pod cannot share a network namespace.
+			if ns == "net" {
+				return errors.New("cannot share the net namespace when NoInfra is set; drop \"net\" from --share or create an infra container")
+			}
 		}
 	}
 
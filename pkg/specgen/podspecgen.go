@@ -78,6 +78,16 @@ type PodBasicConfig struct {
 	Devices []string `json:"pod_devices,omitempty"`
 	// Sysctl sets kernel parameters for the pod
 	Sysctl map[string]string `json:"sysctl,omitempty"`
+	// RestartPolicy is the pod's restart policy - an action which
+	// will be taken when the pod's infra container exits.
+	// Allowed values are "no", "on-failure", "always", and "unless-stopped".
+	// Optional.
+	RestartPolicy string `json:"restart_policy,omitempty"`
+	// RestartRetries is the number of attempts that will be made to restart
+	// the pod's infra container. Used only if RestartPolicy is set to
+	// "on-failure".
+	// Optional.
+	RestartRetries *uint `json:"restart_tries,omitempty"`
 }
 
 // PodNetworkConfig contains networking configuration for a pod.
@@ -163,11 +173,18 @@ type PodStorageConfig struct {
 	// there are conflicts.
 	// Optional.
 	Mounts []spec.Mount `json:"mounts,omitempty"`
-	// Volumes are named volumes that will be added to the pod.
+	// Volumes are named volumes that will be added to every container
+	// joined to the pod, unless a container specifies its own mount at
+	// the same destination.
 	// These will supersede Image Volumes and VolumesFrom  volumes where
 	// there are conflicts.
 	// Optional.
 	Volumes []*NamedVolume `json:"volumes,omitempty"`
+	// Secrets are secrets that will be added to every container joined to
+	// the pod, unless a container specifies its own secret with the same
+	// target.
+	// Optional.
+	Secrets []Secret `json:"pod_secrets,omitempty"`
 	// Overlay volumes are named volumes that will be added to the pod.
 	// Optional.
 	OverlayVolumes []*OverlayVolume `json:"overlay_volumes,omitempty"`
@@ -113,6 +113,12 @@ type ContainerBasicConfig struct {
 	// Only available when RestartPolicy is set to "on-failure".
 	// Optional.
 	RestartRetries *uint `json:"restart_tries,omitempty"`
+	// CheckpointOnStop indicates that the container should be checkpointed
+	// instead of killed when stopped, and restored from that checkpoint
+	// rather than started cold the next time it is started. Requires CRIU
+	// and an OCI runtime that supports checkpoint/restore.
+	// Optional.
+	CheckpointOnStop bool `json:"checkpoint_on_stop,omitempty"`
 	// OCIRuntime is the name of the OCI runtime that will be used to create
 	// the container.
 	// If not specified, the default will be used.
@@ -510,6 +516,12 @@ type ContainerResourceConfig struct {
 	CPUPeriod uint64 `json:"cpu_period,omitempty"`
 	// CPU quota of the cpuset, determined by --cpus
 	CPUQuota int64 `json:"cpu_quota,omitempty"`
+	// CPUAffinity requests automatic CPU pinning for the container.
+	// Presently "auto" is the only accepted value: it pins the container
+	// to a single host core, chosen to spread containers evenly across
+	// cores. Mutually exclusive with ResourceLimits.CPU.Cpus.
+	// Optional.
+	CPUAffinity string `json:"cpu_affinity,omitempty"`
 }
 
 // ContainerHealthCheckConfig describes a container healthcheck with attributes
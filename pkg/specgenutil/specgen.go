@@ -3,6 +3,7 @@ package specgenutil
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strconv"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	envLib "github.com/containers/podman/v4/pkg/env"
 	"github.com/containers/podman/v4/pkg/namespaces"
+	"github.com/containers/podman/v4/pkg/seccomp"
 	"github.com/containers/podman/v4/pkg/specgen"
 	systemdDefine "github.com/containers/podman/v4/pkg/systemd/define"
 	"github.com/containers/podman/v4/pkg/util"
@@ -86,35 +88,35 @@ func getIOLimits(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions) (
 	}
 
 	if len(c.BlkIOWeightDevice) > 0 {
-		if s.WeightDevice, err = parseWeightDevices(c.BlkIOWeightDevice); err != nil {
+		if s.WeightDevice, err = ParseWeightDevices(c.BlkIOWeightDevice); err != nil {
 			return nil, err
 		}
 		hasLimits = true
 	}
 
 	if bps := c.DeviceReadBPs; len(bps) > 0 {
-		if s.ThrottleReadBpsDevice, err = parseThrottleBPSDevices(bps); err != nil {
+		if s.ThrottleReadBpsDevice, err = ParseThrottleBPSDevices(bps); err != nil {
 			return nil, err
 		}
 		hasLimits = true
 	}
 
 	if bps := c.DeviceWriteBPs; len(bps) > 0 {
-		if s.ThrottleWriteBpsDevice, err = parseThrottleBPSDevices(bps); err != nil {
+		if s.ThrottleWriteBpsDevice, err = ParseThrottleBPSDevices(bps); err != nil {
 			return nil, err
 		}
 		hasLimits = true
 	}
 
 	if iops := c.DeviceReadIOPs; len(iops) > 0 {
-		if s.ThrottleReadIOPSDevice, err = parseThrottleIOPsDevices(iops); err != nil {
+		if s.ThrottleReadIOPSDevice, err = ParseThrottleIOPSDevices(iops); err != nil {
 			return nil, err
 		}
 		hasLimits = true
 	}
 
 	if iops := c.DeviceWriteIOPs; len(iops) > 0 {
-		if s.ThrottleWriteIOPSDevice, err = parseThrottleIOPsDevices(iops); err != nil {
+		if s.ThrottleWriteIOPSDevice, err = ParseThrottleIOPSDevices(iops); err != nil {
 			return nil, err
 		}
 		hasLimits = true
@@ -516,6 +518,16 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 		s.ResourceLimits.CPU = getCPULimits(c)
 	}
 
+	if c.CPUAffinity != "" {
+		if c.CPUAffinity != "auto" {
+			return errors.Errorf("invalid value for --cpu-affinity: %q, the only accepted value is \"auto\"", c.CPUAffinity)
+		}
+		if len(c.CPUSetCPUs) != 0 {
+			return errors.New("--cpu-affinity=auto cannot be used with --cpuset-cpus")
+		}
+		s.CPUAffinity = c.CPUAffinity
+	}
+
 	unifieds := make(map[string]string)
 	for _, unified := range c.CgroupConf {
 		splitUnified := strings.SplitN(unified, "=", 2)
@@ -656,6 +668,18 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 		s.SeccompPolicy = c.SeccompPolicy
 	}
 
+	if c.SeccompTrace {
+		traceFile, err := ioutil.TempFile("", "podman-seccomp-trace-")
+		if err != nil {
+			return errors.Wrap(err, "creating seccomp trace output file")
+		}
+		traceFile.Close()
+		if s.Annotations == nil {
+			s.Annotations = make(map[string]string)
+		}
+		s.Annotations[seccomp.TraceAnnotation] = "of:" + traceFile.Name()
+	}
+
 	if len(s.VolumesFrom) == 0 || len(c.VolumesFrom) != 0 {
 		s.VolumesFrom = c.VolumesFrom
 	}
@@ -685,7 +709,7 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 	}
 
 	for _, rule := range c.DeviceCgroupRule {
-		dev, err := parseLinuxResourcesDeviceAccess(rule)
+		dev, err := ParseLinuxResourcesDeviceAccess(rule)
 		if err != nil {
 			return err
 		}
@@ -780,6 +804,8 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 		s.RestartPolicy = splitRestart[0]
 	}
 
+	s.CheckpointOnStop = c.CheckpointOnStop
+
 	if len(s.Secrets) == 0 || len(c.Secrets) != 0 {
 		s.Secrets, s.EnvSecrets, err = parseSecrets(c.Secrets)
 		if err != nil {
@@ -908,7 +934,9 @@ func makeHealthCheckFromCli(inCmd, interval string, retries uint, timeout, start
 	return &hc, nil
 }
 
-func parseWeightDevices(weightDevs []string) (map[string]specs.LinuxWeightDevice, error) {
+// ParseWeightDevices parses device weights in the "device:weight" format
+// accepted by --blkio-weight-device.
+func ParseWeightDevices(weightDevs []string) (map[string]specs.LinuxWeightDevice, error) {
 	wd := make(map[string]specs.LinuxWeightDevice)
 	for _, val := range weightDevs {
 		split := strings.SplitN(val, ":", 2)
@@ -934,7 +962,9 @@ func parseWeightDevices(weightDevs []string) (map[string]specs.LinuxWeightDevice
 	return wd, nil
 }
 
-func parseThrottleBPSDevices(bpsDevices []string) (map[string]specs.LinuxThrottleDevice, error) {
+// ParseThrottleBPSDevices parses device rate limits in the
+// "device:rate[unit]" format accepted by --device-read-bps/--device-write-bps.
+func ParseThrottleBPSDevices(bpsDevices []string) (map[string]specs.LinuxThrottleDevice, error) {
 	td := make(map[string]specs.LinuxThrottleDevice)
 	for _, val := range bpsDevices {
 		split := strings.SplitN(val, ":", 2)
@@ -956,7 +986,9 @@ func parseThrottleBPSDevices(bpsDevices []string) (map[string]specs.LinuxThrottl
 	return td, nil
 }
 
-func parseThrottleIOPsDevices(iopsDevices []string) (map[string]specs.LinuxThrottleDevice, error) {
+// ParseThrottleIOPSDevices parses device rate limits in the
+// "device:rate" format accepted by --device-read-iops/--device-write-iops.
+func ParseThrottleIOPSDevices(iopsDevices []string) (map[string]specs.LinuxThrottleDevice, error) {
 	td := make(map[string]specs.LinuxThrottleDevice)
 	for _, val := range iopsDevices {
 		split := strings.SplitN(val, ":", 2)
@@ -1094,8 +1126,8 @@ var cgroupDeviceAccess = map[string]bool{
 	"m": true, //mknod
 }
 
-// parseLinuxResourcesDeviceAccess parses the raw string passed with the --device-access-add flag
-func parseLinuxResourcesDeviceAccess(device string) (specs.LinuxDeviceCgroup, error) {
+// ParseLinuxResourcesDeviceAccess parses the raw string passed with the --device-cgroup-rule flag
+func ParseLinuxResourcesDeviceAccess(device string) (specs.LinuxDeviceCgroup, error) {
 	var devType, access string
 	var major, minor *int64
 
@@ -138,6 +138,19 @@ func removeArg(arg string, args []string) []string {
 	return newArgs
 }
 
+// appendAdditionalConfig appends the given drop-in snippets to the generated
+// unit content, separated by a blank line each.
+func appendAdditionalConfig(content string, additionalConfig []string) string {
+	var b strings.Builder
+	b.WriteString(content)
+	for _, snippet := range additionalConfig {
+		b.WriteString("\n")
+		b.WriteString(strings.TrimRight(snippet, "\n"))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
 // This function is used to get name of systemd service from prefix, separator, and
 // container/pod name. If prefix is empty, the service name does not include the
 // separator. This is to avoid a situation where service name starts with the separator
@@ -101,6 +101,10 @@ type containerInfo struct {
 	After []string
 	// Similar to Wants, but declares a stronger requirement dependency.
 	Requires []string
+	// SocketActivated indicates that the unit is started on demand by a
+	// matching ".socket" unit, so it should not carry an [Install]
+	// section of its own.
+	SocketActivated bool
 }
 
 const containerTemplate = headerTemplate + `
@@ -153,8 +157,11 @@ Type={{{{.Type}}}}
 NotifyAccess={{{{.NotifyAccess}}}}
 {{{{- end}}}}
 
+{{{{- if not .SocketActivated}}}}
+
 [Install]
 WantedBy=default.target
+{{{{- end}}}}
 `
 
 // ContainerUnit generates a systemd unit for the specified container.  Based
@@ -224,6 +231,7 @@ func generateContainerInfo(ctr *libpod.Container, options entities.GenerateSyste
 		Wants:             options.Wants,
 		After:             options.After,
 		Requires:          options.Requires,
+		SocketActivated:   options.SocketActivated,
 	}
 
 	return &info, nil
@@ -524,5 +532,10 @@ func executeContainerTemplate(info *containerInfo, options entities.GenerateSyst
 		return "", err
 	}
 
-	return buf.String(), nil
+	result := buf.String()
+	if len(options.AdditionalConfig) > 0 {
+		result = appendAdditionalConfig(result, options.AdditionalConfig)
+	}
+
+	return result, nil
 }
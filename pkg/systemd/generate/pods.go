@@ -90,6 +90,10 @@ type podInfo struct {
 	After []string
 	// Similar to Wants, but declares a stronger requirement dependency.
 	Requires []string
+	// SocketActivated indicates that the unit is started on demand by a
+	// matching ".socket" unit, so it should not carry an [Install]
+	// section of its own.
+	SocketActivated bool
 }
 
 const podTemplate = headerTemplate + `Requires={{{{- range $index, $value := .RequiredServices -}}}}{{{{if $index}}}} {{{{end}}}}{{{{ $value }}}}.service{{{{end}}}}
@@ -126,9 +130,11 @@ ExecStop={{{{.ExecStop}}}}
 ExecStopPost={{{{.ExecStopPost}}}}
 PIDFile={{{{.PIDFile}}}}
 Type=forking
+{{{{- if not .SocketActivated}}}}
 
 [Install]
 WantedBy=default.target
+{{{{- end}}}}
 `
 
 // PodUnits generates systemd units for the specified pod and its containers.
@@ -252,6 +258,7 @@ func generatePodInfo(pod *libpod.Pod, options entities.GenerateSystemdOptions) (
 		StopTimeout:       stopTimeout,
 		GenerateTimestamp: true,
 		CreateCommand:     createCommand,
+		SocketActivated:   options.SocketActivated,
 	}
 	return &info, nil
 }
@@ -410,5 +417,10 @@ func executePodTemplate(info *podInfo, options entities.GenerateSystemdOptions)
 		return "", err
 	}
 
-	return buf.String(), nil
+	result := buf.String()
+	if len(options.AdditionalConfig) > 0 {
+		result = appendAdditionalConfig(result, options.AdditionalConfig)
+	}
+
+	return result, nil
 }
@@ -87,6 +87,17 @@ func StringInSlice(s string, sl []string) bool {
 	return false
 }
 
+// StringSliceRemove returns a copy of sl with all occurrences of s removed
+func StringSliceRemove(sl []string, s string) []string {
+	filtered := make([]string, 0, len(sl))
+	for _, i := range sl {
+		if i != s {
+			filtered = append(filtered, i)
+		}
+	}
+	return filtered
+}
+
 // StringMatchRegexSlice determines if a given string matches one of the given regexes, returns bool
 func StringMatchRegexSlice(s string, re []string) bool {
 	for _, r := range re {